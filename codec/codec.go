@@ -0,0 +1,54 @@
+// Package codec frames payloads with a 4-byte big-endian length prefix.
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// MaxFrameSize is the largest payload WriteFrame and ReadFrame accept. It
+// keeps a corrupt or hostile length prefix from causing a huge allocation.
+const MaxFrameSize = 16 << 20
+
+// ErrFrameTooLarge is returned for payloads longer than MaxFrameSize.
+var ErrFrameTooLarge = errors.New("codec: frame exceeds MaxFrameSize")
+
+// WriteFrame writes the length of payload followed by payload itself.
+func WriteFrame(w io.Writer, payload []byte) error {
+	if len(payload) > MaxFrameSize {
+		return ErrFrameTooLarge
+	}
+
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(payload)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// ReadFrame reads one frame written by WriteFrame and returns its payload.
+// It returns io.EOF if r ends before a frame starts and
+// io.ErrUnexpectedEOF if r ends in the middle of one.
+func ReadFrame(r io.Reader) ([]byte, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if size > MaxFrameSize {
+		return nil, ErrFrameTooLarge
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return payload, nil
+}
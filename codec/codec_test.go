@@ -0,0 +1,87 @@
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var tests = [][]byte{
+		{},
+		[]byte("yooo"),
+		bytes.Repeat([]byte{0xab}, MaxFrameSize),
+	}
+
+	for _, payload := range tests {
+		t.Run(fmt.Sprintf("%d bytes", len(payload)), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WriteFrame(&buf, payload); err != nil {
+				t.Fatal(err)
+			}
+			if buf.Len() != 4+len(payload) {
+				t.Errorf("wrote %d bytes, want %d", buf.Len(), 4+len(payload))
+			}
+
+			got, err := ReadFrame(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, payload) {
+				t.Errorf("got %d bytes back, want %d", len(got), len(payload))
+			}
+		})
+	}
+}
+
+func TestReadFrameSequence(t *testing.T) {
+	var buf bytes.Buffer
+	WriteFrame(&buf, []byte("one"))
+	WriteFrame(&buf, []byte{})
+	WriteFrame(&buf, []byte("three"))
+
+	for _, want := range []string{"one", "", "three"} {
+		got, err := ReadFrame(&buf)
+		if err != nil || string(got) != want {
+			t.Errorf("got %q, %v, want %q", got, err, want)
+		}
+	}
+	if _, err := ReadFrame(&buf); err != io.EOF {
+		t.Errorf("got %v, want %v", err, io.EOF)
+	}
+}
+
+func TestReadFrameTruncated(t *testing.T) {
+	var tests = []struct {
+		name string
+		in   []byte
+	}{
+		{"header", []byte{0, 0}},
+		{"payload", []byte{0, 0, 0, 5, 'y', 'o'}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadFrame(bytes.NewReader(tt.in)); err != io.ErrUnexpectedEOF {
+				t.Errorf("got %v, want %v", err, io.ErrUnexpectedEOF)
+			}
+		})
+	}
+}
+
+func TestFrameTooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFrame(&buf, make([]byte, MaxFrameSize+1)); err != ErrFrameTooLarge {
+		t.Errorf("WriteFrame: got %v, want %v", err, ErrFrameTooLarge)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes, want none", buf.Len())
+	}
+
+	// a length prefix of 2 GiB must not be trusted
+	r := bytes.NewReader([]byte{0x7f, 0xff, 0xff, 0xff, 'a'})
+	if _, err := ReadFrame(r); err != ErrFrameTooLarge {
+		t.Errorf("ReadFrame: got %v, want %v", err, ErrFrameTooLarge)
+	}
+}
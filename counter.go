@@ -0,0 +1,24 @@
+package main
+
+import "sync/atomic"
+
+// AtomicCounter is an int64 counter that is safe for concurrent use. The
+// zero value is ready to use.
+type AtomicCounter struct {
+	n atomic.Int64
+}
+
+// Inc adds one to the counter.
+func (c *AtomicCounter) Inc() {
+	c.n.Add(1)
+}
+
+// Add adds n to the counter.
+func (c *AtomicCounter) Add(n int64) {
+	c.n.Add(n)
+}
+
+// Value returns the current count.
+func (c *AtomicCounter) Value() int64 {
+	return c.n.Load()
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicCounter(t *testing.T) {
+	var c AtomicCounter
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				c.Inc()
+			}
+			c.Add(10)
+		}()
+	}
+	wg.Wait()
+
+	if ans, want := c.Value(), int64(50*100+50*10); ans != want {
+		t.Errorf("got %d, want %d", ans, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Package echo runs a tiny TCP server that sends every byte it receives
+// back to the sender, for testing clients without a real server.
+package echo
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// Start listens on a free loopback port and echoes each connection until
+// the peer half-closes it with CloseWrite; then it closes its own write
+// side, so the peer reads everything it sent followed by EOF.
+// The returned stop func closes the listener and any open connections and
+// waits for their goroutines to exit.
+func Start() (addr string, stop func(), err error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", nil, err
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		conns = make(map[net.Conn]struct{})
+		done  bool
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return // listener closed
+			}
+
+			mu.Lock()
+			if done {
+				// accepted while stopping
+				mu.Unlock()
+				conn.Close()
+				return
+			}
+			conns[conn] = struct{}{}
+			mu.Unlock()
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				serve(conn)
+
+				mu.Lock()
+				delete(conns, conn)
+				mu.Unlock()
+			}()
+		}
+	}()
+
+	stop = func() {
+		ln.Close()
+		mu.Lock()
+		done = true
+		for conn := range conns {
+			conn.Close()
+		}
+		mu.Unlock()
+		wg.Wait()
+	}
+	return ln.Addr().String(), stop, nil
+}
+
+func serve(conn net.Conn) {
+	defer conn.Close()
+
+	if _, err := io.Copy(conn, conn); err != nil {
+		return
+	}
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.CloseWrite()
+	}
+}
@@ -0,0 +1,61 @@
+package echo
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	addr, stop, err := Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	for _, msg := range []string{"yooo\n", "", strings.Repeat("big message ", 50000)} {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// write concurrently, since the echo fills the socket buffers
+		// before a large message is fully sent
+		go func() {
+			io.WriteString(conn, msg)
+			conn.(*net.TCPConn).CloseWrite()
+		}()
+
+		got, err := io.ReadAll(conn)
+		conn.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, []byte(msg)) {
+			t.Errorf("got %d bytes back, want %d", len(got), len(msg))
+		}
+	}
+}
+
+func TestStopClosesOpenConns(t *testing.T) {
+	addr, stop, err := Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	io.WriteString(conn, "x")
+	conn.Read(make([]byte, 1))
+
+	// the peer never half-closes, so stop has to close the conn itself
+	stop()
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Errorf("got nil, want the connection to be closed")
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import "sync"
+
+// ErrGroup runs functions concurrently like a WaitGroup, but also keeps the
+// first error any of them returns. The zero value is ready to use.
+type ErrGroup struct {
+	wg   sync.WaitGroup
+	once sync.Once
+	err  error
+}
+
+// Go runs fn in a new goroutine. A panic in fn is recovered and counts as
+// fn returning a *PanicError.
+func (g *ErrGroup) Go(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if err := safeCall(fn); err != nil {
+			g.once.Do(func() {
+				g.err = err
+			})
+		}
+	}()
+}
+
+// Wait blocks until every function started with Go has returned, then
+// returns the first non-nil error, if any.
+func (g *ErrGroup) Wait() error {
+	g.wg.Wait()
+	return g.err
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestErrGroupAllSucceed(t *testing.T) {
+	var g ErrGroup
+	var count atomic.Int64
+	for i := 0; i < 10; i++ {
+		g.Go(func() error {
+			count.Add(1)
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+	if ans := count.Load(); ans != 10 {
+		t.Errorf("got %d, want %d", ans, 10)
+	}
+}
+
+func TestErrGroupOneError(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	var g ErrGroup
+	for i := 0; i < 10; i++ {
+		i := i
+		g.Go(func() error {
+			if i == 3 {
+				return errBoom
+			}
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != errBoom {
+		t.Errorf("got %v, want %v", err, errBoom)
+	}
+}
+
+func TestErrGroupFirstError(t *testing.T) {
+	errFirst := errors.New("first")
+	errLater := errors.New("later")
+
+	var g ErrGroup
+	g.Go(func() error {
+		return errFirst
+	})
+	for i := 0; i < 5; i++ {
+		g.Go(func() error {
+			time.Sleep(20 * time.Millisecond)
+			return errLater
+		})
+	}
+
+	if err := g.Wait(); err != errFirst {
+		t.Errorf("got %v, want %v", err, errFirst)
+	}
+}
@@ -0,0 +1,31 @@
+package io
+
+import (
+	"encoding/base64"
+	"io"
+)
+
+// Base64EncodeStream returns a reader yielding the standard base64 encoding
+// of r. The encoder is a writer, so it runs in a goroutine behind an io.Pipe.
+func Base64EncodeStream(r io.Reader) io.Reader {
+	pr, pw := io.Pipe()
+
+	go func() {
+		enc := base64.NewEncoder(base64.StdEncoding, pw)
+		_, err := io.Copy(enc, r)
+		// Close flushes the last partial block and its padding
+		if cerr := enc.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr
+}
+
+// Base64DecodeStream returns a reader yielding the decoded form of the
+// standard base64 stream r. The decoder already is a reader, so no pipe is
+// needed here.
+func Base64DecodeStream(r io.Reader) io.Reader {
+	return base64.NewDecoder(base64.StdEncoding, r)
+}
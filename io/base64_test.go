@@ -0,0 +1,37 @@
+package io
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestBase64StreamRoundTrip(t *testing.T) {
+	// lengths around multiples of 3 cover every padding case
+	for _, size := range []int{0, 1, 2, 3, 4, 5, 255, 1000} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			input := make([]byte, size)
+			for i := range input {
+				input[i] = byte(i * 7)
+			}
+
+			encoded, err := io.ReadAll(Base64EncodeStream(bytes.NewReader(input)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := base64.StdEncoding.EncodeToString(input); string(encoded) != want {
+				t.Errorf("got encoding %q, want %q", encoded, want)
+			}
+
+			decoded, err := io.ReadAll(Base64DecodeStream(bytes.NewReader(encoded)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(decoded, input) {
+				t.Errorf("got %x, want %x", decoded, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package io
+
+import (
+	"bytes"
+	"io"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+type bomReader struct {
+	r       io.Reader
+	checked bool
+}
+
+// BOMReader returns a reader that drops a UTF-8 byte order mark at the very
+// start of r. The same bytes later in the stream are passed through.
+func BOMReader(r io.Reader) io.Reader {
+	return &bomReader{r: r}
+}
+
+func (b *bomReader) Read(p []byte) (int, error) {
+	if !b.checked {
+		head := make([]byte, len(utf8BOM))
+		n, err := io.ReadFull(b.r, head)
+		head = head[:n]
+		switch err {
+		case nil:
+			if bytes.Equal(head, utf8BOM) {
+				head = nil
+			}
+			b.r = io.MultiReader(bytes.NewReader(head), b.r)
+		case io.EOF, io.ErrUnexpectedEOF:
+			// the whole input fit into head
+			b.r = bytes.NewReader(head)
+		default:
+			// put back what was read, so the next Read checks it again
+			b.r = io.MultiReader(bytes.NewReader(head), b.r)
+			return 0, err
+		}
+		b.checked = true
+	}
+	return b.r.Read(p)
+}
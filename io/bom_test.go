@@ -0,0 +1,128 @@
+package io
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestBOMReader(t *testing.T) {
+	const bom = "\xef\xbb\xbf"
+
+	var tests = []struct {
+		name, in, want string
+	}{
+		{"leading", bom + "yooo", "yooo"},
+		{"none", "yooo", "yooo"},
+		{"mid-stream", "yo" + bom + "oo", "yo" + bom + "oo"},
+		{"only bom", bom, ""},
+		{"short", "ab", "ab"},
+		{"empty", "", ""},
+		{"partial bom", "\xef\xbbx", "\xef\xbbx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := io.ReadAll(BOMReader(strings.NewReader(tt.in)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBOMReaderOneByteReads(t *testing.T) {
+	r := BOMReader(iotest.OneByteReader(strings.NewReader("\xef\xbb\xbfyooo")))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "yooo" {
+		t.Errorf("got %q, want %q", got, "yooo")
+	}
+}
+
+// flakyReader fails once with err after the first part, then carries on
+// with the rest.
+type flakyReader struct {
+	parts []io.Reader
+	err   error
+}
+
+func (f *flakyReader) Read(p []byte) (int, error) {
+	if len(f.parts) == 0 {
+		return 0, io.EOF
+	}
+	n, err := f.parts[0].Read(p)
+	if err == io.EOF {
+		f.parts = f.parts[1:]
+		if f.err != nil {
+			err, f.err = f.err, nil
+			return n, err
+		}
+		return n, nil
+	}
+	return n, err
+}
+
+func TestBOMReaderTransientError(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	var tests = []struct {
+		name, first, rest, want string
+	}{
+		{"text", "ab", "cd", "abcd"},
+		{"split bom", "\xef", "\xbb\xbfyooo", "yooo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := BOMReader(&flakyReader{
+				parts: []io.Reader{strings.NewReader(tt.first), strings.NewReader(tt.rest)},
+				err:   errFlaky,
+			})
+
+			var got []byte
+			buf := make([]byte, 8)
+			sawErr := false
+			for {
+				n, err := r.Read(buf)
+				got = append(got, buf[:n]...)
+				if err == io.EOF {
+					break
+				}
+				if err == errFlaky {
+					sawErr = true
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if !sawErr {
+				t.Errorf("got no error, want %v once", errFlaky)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBOMReaderPersistentError(t *testing.T) {
+	errDown := errors.New("down")
+	r := BOMReader(ErrorAfterReader(strings.NewReader("abcd"), 2, errDown))
+
+	// the head check is retried, so the error is reported on every Read
+	// while the source stays broken
+	for i := 0; i < 2; i++ {
+		if n, err := r.Read(make([]byte, 8)); n != 0 || err != errDown {
+			t.Errorf("read %d: got %d, %v, want 0, %v", i, n, err, errDown)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package io
+
+import "io"
+
+type channelReader struct {
+	ch   <-chan []byte
+	rest []byte
+}
+
+// ChannelReader returns a reader yielding the chunks received on ch, in
+// order. It returns io.EOF once ch is closed and fully consumed.
+func ChannelReader(ch <-chan []byte) io.Reader {
+	return &channelReader{ch: ch}
+}
+
+func (c *channelReader) Read(p []byte) (int, error) {
+	// keep what did not fit into the previous Read
+	for len(c.rest) == 0 {
+		chunk, ok := <-c.ch
+		if !ok {
+			return 0, io.EOF
+		}
+		c.rest = chunk
+	}
+
+	n := copy(p, c.rest)
+	c.rest = c.rest[n:]
+	return n, nil
+}
+
+type channelWriter struct {
+	ch chan<- []byte
+}
+
+// ChannelWriter returns a writer that sends a copy of every written slice
+// on ch, so the caller is free to reuse its buffer after Write returns.
+func ChannelWriter(ch chan<- []byte) io.Writer {
+	return &channelWriter{ch: ch}
+}
+
+func (c *channelWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	c.ch <- chunk
+	return len(p), nil
+}
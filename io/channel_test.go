@@ -0,0 +1,70 @@
+package io
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestChannelReader(t *testing.T) {
+	ch := make(chan []byte, 3)
+	ch <- []byte("yooo ")
+	ch <- []byte("channel ")
+	ch <- []byte("reader")
+	close(ch)
+
+	r := ChannelReader(ch)
+
+	// a 3-byte buffer splits every chunk, exercising the leftover path
+	var got []byte
+	buf := make([]byte, 3)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if string(got) != "yooo channel reader" {
+		t.Errorf("got %q, want %q", got, "yooo channel reader")
+	}
+}
+
+func TestChannelWriter(t *testing.T) {
+	input := strings.Repeat("yooo channel writer\n", 5000)
+	ch := make(chan []byte)
+
+	done := make(chan []byte)
+	go func() {
+		var got []byte
+		for chunk := range ch {
+			got = append(got, chunk...)
+		}
+		done <- got
+	}()
+
+	if _, err := io.Copy(ChannelWriter(ch), strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	close(ch)
+
+	if got := <-done; string(got) != input {
+		t.Errorf("got %d bytes, want the %d input bytes", len(got), len(input))
+	}
+}
+
+func TestChannelWriterCopies(t *testing.T) {
+	ch := make(chan []byte, 1)
+	buf := []byte("yooo")
+
+	ChannelWriter(ch).Write(buf)
+	buf[0] = 'X'
+
+	if got := <-ch; string(got) != "yooo" {
+		t.Errorf("got %q, want %q", got, "yooo")
+	}
+}
@@ -0,0 +1,37 @@
+package io
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+)
+
+// CopyWithChecksum copies src to dst and computes the SHA-256 of the copied
+// bytes on the way, so the data does not need to be read twice.
+func CopyWithChecksum(dst io.Writer, src io.Reader) (n int64, sum [32]byte, err error) {
+	h := sha256.New()
+	mw := io.MultiWriter(dst, h)
+
+	n, err = io.Copy(mw, src)
+	copy(sum[:], h.Sum(nil))
+	return n, sum, err
+}
+
+// CopyReport is CopyWithChecksum with the digest returned as a lowercase
+// hex string, handy for printing or comparing with sha256sum output.
+func CopyReport(dst io.Writer, src io.Reader) (n int64, sha string, err error) {
+	n, sum, err := CopyWithChecksum(dst, src)
+	return n, hex.EncodeToString(sum[:]), err
+}
+
+// TeeHashes returns a reader that reads from r and feeds every byte read to
+// all hashers. Once the caller has drained it, each hasher holds the digest
+// of the whole input.
+func TeeHashes(r io.Reader, hashers map[string]hash.Hash) io.Reader {
+	ws := make([]io.Writer, 0, len(hashers))
+	for _, h := range hashers {
+		ws = append(ws, h)
+	}
+	return io.TeeReader(r, io.MultiWriter(ws...))
+}
@@ -0,0 +1,90 @@
+package io
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCopyWithChecksum(t *testing.T) {
+	input := strings.Repeat("yooo checksum\n", 1000)
+
+	var dst bytes.Buffer
+	n, sum, err := CopyWithChecksum(&dst, strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != int64(len(input)) {
+		t.Errorf("got n %d, want %d", n, len(input))
+	}
+	if dst.String() != input {
+		t.Error("destination does not hold the input")
+	}
+	if want := sha256.Sum256([]byte(input)); sum != want {
+		t.Errorf("got sum %x, want %x", sum, want)
+	}
+}
+
+func TestCopyReport(t *testing.T) {
+	var tests = []struct {
+		in, sha string
+	}{
+		// digests as printed by sha256sum
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{"abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var dst bytes.Buffer
+			n, sha, err := CopyReport(&dst, strings.NewReader(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != int64(len(tt.in)) || dst.String() != tt.in {
+				t.Errorf("got n %d and %q, want %d and %q", n, dst.String(), len(tt.in), tt.in)
+			}
+			if sha != tt.sha {
+				t.Errorf("got %s, want %s", sha, tt.sha)
+			}
+		})
+	}
+}
+
+func TestTeeHashes(t *testing.T) {
+	input := []byte(strings.Repeat("yooo hashes\n", 1000))
+
+	hashers := map[string]hash.Hash{
+		"md5":    md5.New(),
+		"sha1":   sha1.New(),
+		"sha256": sha256.New(),
+	}
+	got, err := io.ReadAll(TeeHashes(bytes.NewReader(input), hashers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, input) {
+		t.Errorf("got %d bytes, want the input passed through", len(got))
+	}
+
+	md5Sum := md5.Sum(input)
+	sha1Sum := sha1.Sum(input)
+	sha256Sum := sha256.Sum256(input)
+	want := map[string][]byte{
+		"md5":    md5Sum[:],
+		"sha1":   sha1Sum[:],
+		"sha256": sha256Sum[:],
+	}
+	for name, h := range hashers {
+		if ans := h.Sum(nil); !bytes.Equal(ans, want[name]) {
+			t.Errorf("%s: got %s, want %s", name, hex.EncodeToString(ans), hex.EncodeToString(want[name]))
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package io
+
+import (
+	"io"
+	"os"
+	"time"
+)
+
+// ReadAllWithDeadline is io.ReadAll that gives up once reading has taken
+// longer than d. The deadline is checked between reads, so a single blocked
+// Read is not interrupted. On timeout it returns the bytes read so far
+// together with os.ErrDeadlineExceeded.
+func ReadAllWithDeadline(r io.Reader, d time.Duration) ([]byte, error) {
+	deadline := time.Now().Add(d)
+	b := make([]byte, 0, 512)
+
+	for {
+		if time.Now().After(deadline) {
+			return b, os.ErrDeadlineExceeded
+		}
+
+		if len(b) == cap(b) {
+			// let append grow the backing array
+			b = append(b, 0)[:len(b)]
+		}
+
+		n, err := r.Read(b[len(b):cap(b)])
+		b = b[:len(b)+n]
+		if err == io.EOF {
+			return b, nil
+		}
+		if err != nil {
+			return b, err
+		}
+	}
+}
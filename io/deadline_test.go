@@ -0,0 +1,37 @@
+package io
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadAllWithDeadline(t *testing.T) {
+	slow := &slowReader{s: "hello", delay: time.Millisecond}
+
+	got, err := ReadAllWithDeadline(slow, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello" {
+		t.Errorf("got %q, want %q", got, "hello")
+	}
+}
+
+func TestReadAllWithDeadlineExceeded(t *testing.T) {
+	// 100 bytes at 5ms each would take 500ms
+	slow := &slowReader{s: strings.Repeat("x", 100), delay: 5 * time.Millisecond}
+
+	got, err := ReadAllWithDeadline(slow, 50*time.Millisecond)
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("got %v, want %v", err, os.ErrDeadlineExceeded)
+	}
+	if len(got) == 0 || len(got) >= 100 {
+		t.Errorf("got %d bytes, want a partial read", len(got))
+	}
+	if strings.Trim(string(got), "x") != "" {
+		t.Errorf("got %q, want only the bytes read so far", got)
+	}
+}
@@ -0,0 +1,9 @@
+package io
+
+import "io"
+
+// Drain reads r to the end, throwing the data away, and returns how many
+// bytes were discarded.
+func Drain(r io.Reader) (int64, error) {
+	return io.Copy(io.Discard, r)
+}
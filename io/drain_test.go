@@ -0,0 +1,24 @@
+package io
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDrain(t *testing.T) {
+	for _, size := range []int{0, 1, 4096, 100000} {
+		r := strings.NewReader(strings.Repeat("x", size))
+
+		n, err := Drain(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(size) {
+			t.Errorf("got %d, want %d", n, size)
+		}
+		if _, err := r.Read(make([]byte, 1)); err != io.EOF {
+			t.Errorf("got %v after Drain, want %v", err, io.EOF)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package io
+
+import (
+	"errors"
+	"io"
+)
+
+// IsGracefulEnd reports whether err only means the other side stopped
+// sending, for reads where a half-close is expected.
+//
+// io.EOF means the stream ended cleanly between reads. io.ErrUnexpectedEOF
+// means it ended in the middle of something the reader was still waiting
+// for, e.g. io.ReadFull getting fewer bytes than asked. Both are a normal
+// end of input when the peer is allowed to close at any time; any other
+// error is a real failure.
+func IsGracefulEnd(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,30 @@
+package io
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestIsGracefulEnd(t *testing.T) {
+	var tests = []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{io.EOF, true},
+		{io.ErrUnexpectedEOF, true},
+		{fmt.Errorf("read frame: %w", io.EOF), true},
+		{errors.New("connection reset"), false},
+		{io.ErrClosedPipe, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.err), func(t *testing.T) {
+			if ans := IsGracefulEnd(tt.err); ans != tt.want {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
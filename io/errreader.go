@@ -0,0 +1,29 @@
+package io
+
+import "io"
+
+type errorAfterReader struct {
+	r    io.Reader
+	left int
+	err  error
+}
+
+// ErrorAfterReader returns a reader that passes through the first
+// afterBytes bytes of r and then fails every Read with err. It is meant for
+// exercising error paths in copy code.
+func ErrorAfterReader(r io.Reader, afterBytes int, err error) io.Reader {
+	return &errorAfterReader{r: r, left: afterBytes, err: err}
+}
+
+func (e *errorAfterReader) Read(p []byte) (int, error) {
+	if e.left <= 0 {
+		return 0, e.err
+	}
+	if len(p) > e.left {
+		p = p[:e.left]
+	}
+
+	n, err := e.r.Read(p)
+	e.left -= n
+	return n, err
+}
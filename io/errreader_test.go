@@ -0,0 +1,31 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestErrorAfterReader(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	var dst bytes.Buffer
+	n, err := io.Copy(&dst, ErrorAfterReader(strings.NewReader("yooo error reader"), 4, errBoom))
+
+	if !errors.Is(err, errBoom) {
+		t.Errorf("got %v, want %v", err, errBoom)
+	}
+	if n != 4 || dst.String() != "yooo" {
+		t.Errorf("got %d bytes %q, want 4 bytes %q", n, dst.String(), "yooo")
+	}
+}
+
+func TestErrorAfterReaderShortSource(t *testing.T) {
+	// the source ends before afterBytes, so its EOF comes first
+	got, err := io.ReadAll(ErrorAfterReader(strings.NewReader("yo"), 10, errors.New("boom")))
+	if err != nil || string(got) != "yo" {
+		t.Errorf("got %q, %v; want %q, nil", got, err, "yo")
+	}
+}
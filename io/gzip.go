@@ -0,0 +1,70 @@
+package io
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+// GzipPipe returns a reader yielding the gzip-compressed form of src.
+// Compression runs in its own goroutine feeding an io.Pipe.
+func GzipPipe(src io.Reader) io.Reader {
+	pr, pw := io.Pipe()
+
+	go func() {
+		zw := gzip.NewWriter(pw)
+		_, err := io.Copy(zw, src)
+		if cerr := zw.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr
+}
+
+// GunzipPipe is the inverse of GzipPipe: it returns a reader yielding the
+// decompressed form of the gzip stream src.
+func GunzipPipe(src io.Reader) io.Reader {
+	pr, pw := io.Pipe()
+
+	go func() {
+		zr, err := gzip.NewReader(src)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		_, err = io.Copy(pw, zr)
+		if cerr := zr.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr
+}
+
+// CopyCompressed copies src to dst gzip-compressed and returns the number of
+// uncompressed bytes read from src. The gzip writer is closed before
+// returning, so dst always gets the complete stream including its trailer.
+func CopyCompressed(dst io.Writer, src io.Reader) (int64, error) {
+	zw := gzip.NewWriter(dst)
+	n, err := io.Copy(zw, src)
+	if cerr := zw.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
+
+// CopyDecompressed copies the decompressed form of the gzip stream src to
+// dst and returns the number of decompressed bytes written.
+func CopyDecompressed(dst io.Writer, src io.Reader) (int64, error) {
+	zr, err := gzip.NewReader(src)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(dst, zr)
+	if cerr := zr.Close(); err == nil {
+		err = cerr
+	}
+	return n, err
+}
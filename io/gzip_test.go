@@ -0,0 +1,64 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestGzipPipeRoundTrip(t *testing.T) {
+	input := strings.Repeat("yooo gzip ", 1000)
+
+	compressed, err := io.ReadAll(GzipPipe(strings.NewReader(input)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(compressed) >= len(input) {
+		t.Errorf("compressed %d bytes into %d", len(input), len(compressed))
+	}
+
+	out, err := io.ReadAll(GunzipPipe(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != input {
+		t.Error("round trip did not reproduce the input")
+	}
+}
+
+func TestGunzipPipeInvalid(t *testing.T) {
+	_, err := io.ReadAll(GunzipPipe(strings.NewReader("not gzip")))
+	if err == nil {
+		t.Error("got nil error for invalid input")
+	}
+}
+
+func TestCopyCompressedRoundTrip(t *testing.T) {
+	input := strings.Repeat("yooo copy ", 1000)
+
+	var compressed bytes.Buffer
+	n, err := CopyCompressed(&compressed, strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(input)) {
+		t.Errorf("got n %d, want %d", n, len(input))
+	}
+
+	var out bytes.Buffer
+	n, err = CopyDecompressed(&out, &compressed)
+	if err != nil {
+		// a missing trailer shows up here as io.ErrUnexpectedEOF
+		t.Fatal(err)
+	}
+	if n != int64(len(input)) || out.String() != input {
+		t.Error("round trip did not reproduce the input")
+	}
+}
+
+func TestCopyDecompressedInvalid(t *testing.T) {
+	if _, err := CopyDecompressed(io.Discard, strings.NewReader("not gzip")); err == nil {
+		t.Error("got nil error for invalid input")
+	}
+}
@@ -0,0 +1,14 @@
+package io
+
+import (
+	"encoding/hex"
+	"io"
+)
+
+// HexDumpWriter returns a writer that renders everything written to it as
+// a hexdump -C style offset/hex/ASCII dump into w. Close must be called to
+// write the final partial line. Combine it with io.MultiWriter to get the
+// normal output and the dump at the same time.
+func HexDumpWriter(w io.Writer) io.WriteCloser {
+	return hex.Dumper(w)
+}
@@ -0,0 +1,26 @@
+package io
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestHexDumpWriter(t *testing.T) {
+	var out bytes.Buffer
+
+	w := HexDumpWriter(&out)
+	w.Write([]byte("yooo"))
+	w.Write([]byte(" hex\n"))
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "00000000  79 6f 6f 6f 20 68 65 78  0a                       |yooo hex.|\n"
+	if out.String() != want {
+		t.Errorf("got\n%q\nwant\n%q", out.String(), want)
+	}
+	if out.String() != hex.Dump([]byte("yooo hex\n")) {
+		t.Error("output differs from hex.Dump")
+	}
+}
@@ -1,7 +1,6 @@
 package io
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -15,6 +14,41 @@ func foo(w *io.PipeWriter) {
 }
 func Io() {
 	ten()
+	eleven()
+}
+
+func eleven() {
+	resp, err := requestResponse("yooo eleven", strings.ToUpper)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(resp)
+}
+
+// requestResponse sends req to a "server" goroutine over one pipe and
+// reads its answer, transform(req), from a second pipe.
+func requestResponse(req string, transform func(string) string) (string, error) {
+	reqR, reqW := io.Pipe()
+	respR, respW := io.Pipe()
+
+	// "server": read the whole request, answer on the second pipe
+	go func() {
+		req, err := io.ReadAll(reqR)
+		if err != nil {
+			respW.CloseWithError(err)
+			return
+		}
+		defer respW.Close()
+		fmt.Fprint(respW, transform(string(req)))
+	}()
+
+	// write the request and close, so the server sees EOF
+	fmt.Fprint(reqW, req)
+	reqW.Close()
+
+	resp, err := io.ReadAll(respR)
+	return string(resp), err
 }
 
 func ten() {
@@ -23,8 +57,9 @@ func ten() {
 	// Pass writer to function
 	go foo(pw)
 
-	var b bytes.Buffer
-	mw := io.MultiWriter(&b, os.Stdout)
+	b := GetBuffer()
+	defer PutBuffer(b)
+	mw := io.MultiWriter(b, os.Stdout)
 
 	_, err := io.Copy(mw, pr)
 	if err != nil {
@@ -52,9 +87,10 @@ func nine() {
 func eight() {
 	r := strings.NewReader("yooo eight")
 
-	var b bytes.Buffer
+	b := GetBuffer()
+	defer PutBuffer(b)
 
-	_, err := io.Copy(&b, r)
+	_, err := io.Copy(b, r)
 	if err != nil {
 		panic(err)
 	}
@@ -65,7 +101,8 @@ func eight() {
 func seven() {
 	r := strings.NewReader("yooo seven")
 
-	var b bytes.Buffer
+	b := GetBuffer()
+	defer PutBuffer(b)
 
 	b.ReadFrom(r)
 
@@ -77,17 +114,20 @@ func one() {
 }
 
 func two() {
-	var b bytes.Buffer
+	b := GetBuffer()
+	defer PutBuffer(b)
 
-	fmt.Fprintln(&b, "yooo two")
+	fmt.Fprintln(b, "yooo two")
 	fmt.Println(b.String())
 }
 
 func three() {
-	var a bytes.Buffer
-	var b bytes.Buffer
+	a := GetBuffer()
+	defer PutBuffer(a)
+	b := GetBuffer()
+	defer PutBuffer(b)
 
-	mb := io.MultiWriter(&a, &b)
+	mb := io.MultiWriter(a, b)
 
 	fmt.Fprintln(mb, "yooo three")
 	fmt.Println(a.String())
@@ -123,9 +163,10 @@ func five() {
 func six() {
 	r := strings.NewReader("yooo six")
 
-	var b bytes.Buffer
+	b := GetBuffer()
+	defer PutBuffer(b)
 
-	r.WriteTo(&b)
+	r.WriteTo(b)
 
 	fmt.Println(b.String())
 }
@@ -0,0 +1,30 @@
+package io
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRequestResponse(t *testing.T) {
+	var tests = []struct {
+		req       string
+		transform func(string) string
+		want      string
+	}{
+		{"yooo eleven", strings.ToUpper, "YOOO ELEVEN"},
+		{"", strings.ToUpper, ""},
+		{"a b c", func(s string) string { return strings.ReplaceAll(s, " ", "") }, "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.req, func(t *testing.T) {
+			got, err := requestResponse(tt.req, tt.transform)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrBufferFull is returned by LimitedBuffer when a write would grow it
+// past its Max.
+var ErrBufferFull = errors.New("limited buffer: write exceeds max size")
+
+// LimitedBuffer is an in-memory writer like bytes.Buffer that refuses to
+// grow beyond Max bytes. A write that does not fit is rejected whole.
+type LimitedBuffer struct {
+	Max int
+
+	buf bytes.Buffer
+}
+
+func (l *LimitedBuffer) Write(p []byte) (int, error) {
+	if l.buf.Len()+len(p) > l.Max {
+		return 0, ErrBufferFull
+	}
+	return l.buf.Write(p)
+}
+
+// Bytes returns the bytes written so far.
+func (l *LimitedBuffer) Bytes() []byte {
+	return l.buf.Bytes()
+}
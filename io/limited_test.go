@@ -0,0 +1,42 @@
+package io
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLimitedBuffer(t *testing.T) {
+	var tests = []struct {
+		max    int
+		writes []string
+		want   string
+		full   bool
+	}{
+		{4, []string{"yooo"}, "yooo", false},
+		{4, []string{"yo", "oo"}, "yooo", false},
+		{4, []string{"yoooo"}, "", true},
+		{4, []string{"yoo", "oo"}, "yoo", true},
+		{0, []string{""}, "", false},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%q", tt.max, tt.writes)
+		t.Run(testname, func(t *testing.T) {
+			b := &LimitedBuffer{Max: tt.max}
+
+			var err error
+			for _, w := range tt.writes {
+				if _, err = b.Write([]byte(w)); err != nil {
+					break
+				}
+			}
+
+			if full := err == ErrBufferFull; full != tt.full {
+				t.Errorf("got error %v, want full %v", err, tt.full)
+			}
+			if string(b.Bytes()) != tt.want {
+				t.Errorf("got %q, want %q", b.Bytes(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package io
+
+import (
+	"bytes"
+	"io"
+)
+
+// LineCountReader reads from R and counts the '\n' bytes that pass through
+// in Lines. A last line without a trailing newline is not counted.
+type LineCountReader struct {
+	R     io.Reader
+	Lines int
+}
+
+func (l *LineCountReader) Read(p []byte) (int, error) {
+	n, err := l.R.Read(p)
+	l.Lines += bytes.Count(p[:n], []byte{'\n'})
+	return n, err
+}
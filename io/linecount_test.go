@@ -0,0 +1,40 @@
+package io
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLineCountReader(t *testing.T) {
+	var tests = []struct {
+		input string
+		want  int
+	}{
+		{"", 0},
+		{"one line, no newline", 0},
+		{"one\n", 1},
+		{"one\ntwo\nthree\n", 3},
+		{"one\ntwo\nthree", 2},
+		{"\n\n\n", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			// one byte at a time checks counting across reads
+			r := &LineCountReader{R: iotest.OneByteReader(strings.NewReader(tt.input))}
+
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.input {
+				t.Errorf("got %q, want the input passed through", got)
+			}
+			if r.Lines != tt.want {
+				t.Errorf("got %d lines, want %d", r.Lines, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package io
+
+import (
+	"io"
+
+	"tutorial/codec"
+)
+
+// MaxMessageSize is the largest Body a Message may be written or read with.
+const MaxMessageSize = codec.MaxFrameSize
+
+// ErrMessageTooLarge is returned for a Body longer than MaxMessageSize.
+var ErrMessageTooLarge = codec.ErrFrameTooLarge
+
+// Message is a string serialized as one codec frame: a 4-byte big-endian
+// length followed by the string bytes. It implements io.WriterTo and
+// io.ReaderFrom.
+type Message struct {
+	Body string
+}
+
+func (m *Message) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	err := codec.WriteFrame(cw, []byte(m.Body))
+	return cw.n, err
+}
+
+// ReadFrom reads exactly one message from r, replacing m.Body. Unlike most
+// ReaderFrom implementations it stops after the message instead of at EOF.
+func (m *Message) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingReader{r: r}
+	body, err := codec.ReadFrame(cr)
+	if err != nil {
+		return cr.n, err
+	}
+
+	m.Body = string(body)
+	return cr.n, nil
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
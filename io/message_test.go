@@ -0,0 +1,65 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	for _, body := range []string{"", "yooo", "line one\nline two", strings.Repeat("x", 70000)} {
+		var buf bytes.Buffer
+
+		in := Message{Body: body}
+		wn, err := in.WriteTo(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var out Message
+		rn, err := out.ReadFrom(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if out != in {
+			t.Errorf("got body of %d bytes, want %d", len(out.Body), len(in.Body))
+		}
+		if wn != rn || wn != int64(4+len(body)) {
+			t.Errorf("wrote %d and read %d bytes, want %d", wn, rn, 4+len(body))
+		}
+	}
+}
+
+func TestMessageReadFromTooLarge(t *testing.T) {
+	// a length prefix of 2 GiB must not be trusted
+	r := bytes.NewReader([]byte{0x7f, 0xff, 0xff, 0xff, 'a'})
+
+	var m Message
+	if _, err := m.ReadFrom(r); !errors.Is(err, ErrMessageTooLarge) {
+		t.Errorf("got %v, want %v", err, ErrMessageTooLarge)
+	}
+}
+
+func TestMessageWriteToTooLarge(t *testing.T) {
+	m := Message{Body: strings.Repeat("x", MaxMessageSize+1)}
+
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); !errors.Is(err, ErrMessageTooLarge) {
+		t.Errorf("got %v, want %v", err, ErrMessageTooLarge)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %d bytes, want none", buf.Len())
+	}
+}
+
+func TestMessageReadFromTruncated(t *testing.T) {
+	r := bytes.NewReader([]byte{0, 0, 0, 5, 'y', 'o'})
+
+	var m Message
+	if _, err := m.ReadFrom(r); err != io.ErrUnexpectedEOF {
+		t.Errorf("got %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
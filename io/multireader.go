@@ -0,0 +1,58 @@
+package io
+
+import (
+	"bytes"
+	"context"
+	"io"
+)
+
+type ctxMultiReader struct {
+	ctx     context.Context
+	readers []io.Reader
+}
+
+// MultiReaderContext is like io.MultiReader, but checks ctx before every
+// underlying Read and returns ctx.Err() once it is cancelled.
+func MultiReaderContext(ctx context.Context, readers ...io.Reader) io.Reader {
+	r := make([]io.Reader, len(readers))
+	copy(r, readers)
+	return &ctxMultiReader{ctx: ctx, readers: r}
+}
+
+func (m *ctxMultiReader) Read(p []byte) (int, error) {
+	for len(m.readers) > 0 {
+		if err := m.ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		n, err := m.readers[0].Read(p)
+		if err == io.EOF {
+			m.readers = m.readers[1:]
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+	return 0, io.EOF
+}
+
+// ConcatReader is like io.MultiReader, but yields sep between the contents
+// of consecutive readers. No separator follows the last one.
+func ConcatReader(sep []byte, readers ...io.Reader) io.Reader {
+	parts := make([]io.Reader, 0, 2*len(readers))
+	for i, r := range readers {
+		if i > 0 {
+			parts = append(parts, bytes.NewReader(sep))
+		}
+		parts = append(parts, r)
+	}
+	return io.MultiReader(parts...)
+}
+
+// PrefixReader returns a reader that yields prefix and then the contents of
+// r, without copying r into memory.
+func PrefixReader(prefix []byte, r io.Reader) io.Reader {
+	return io.MultiReader(bytes.NewReader(prefix), r)
+}
@@ -0,0 +1,105 @@
+package io
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// slowReader returns one byte of s per Read, sleeping before each.
+type slowReader struct {
+	s     string
+	delay time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if r.s == "" {
+		return 0, io.EOF
+	}
+	time.Sleep(r.delay)
+	n := copy(p[:1], r.s)
+	r.s = r.s[n:]
+	return n, nil
+}
+
+func TestMultiReaderContext(t *testing.T) {
+	got, err := io.ReadAll(MultiReaderContext(context.Background(),
+		strings.NewReader("yooo "), strings.NewReader(""), strings.NewReader("five")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "yooo five" {
+		t.Errorf("got %q, want %q", got, "yooo five")
+	}
+}
+
+func TestMultiReaderContextCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	slow := &slowReader{s: strings.Repeat("x", 100), delay: 5 * time.Millisecond}
+	got, err := io.ReadAll(MultiReaderContext(ctx, strings.NewReader("start "), slow))
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !strings.HasPrefix(string(got), "start ") || len(got) >= len("start ")+100 {
+		t.Errorf("got %d bytes, want to stop part way through the slow reader", len(got))
+	}
+}
+
+func TestConcatReader(t *testing.T) {
+	var tests = []struct {
+		name  string
+		sep   string
+		parts []string
+		want  string
+	}{
+		{"three", "\n", []string{"one", "two", "three"}, "one\ntwo\nthree"},
+		{"one", "\n", []string{"only"}, "only"},
+		{"none", "\n", nil, ""},
+		{"empty part", ", ", []string{"a", "", "b"}, "a, , b"},
+		{"no sep", "", []string{"a", "b"}, "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readers := make([]io.Reader, len(tt.parts))
+			for i, p := range tt.parts {
+				readers[i] = strings.NewReader(p)
+			}
+
+			got, err := io.ReadAll(ConcatReader([]byte(tt.sep), readers...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixReader(t *testing.T) {
+	r := PrefixReader([]byte("HEADER:"), strings.NewReader("body"))
+
+	// a 3-byte buffer takes several reads to get through the prefix
+	var got []byte
+	buf := make([]byte, 3)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if string(got) != "HEADER:body" {
+		t.Errorf("got %q, want %q", got, "HEADER:body")
+	}
+}
@@ -0,0 +1,79 @@
+package io
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+type allWriter struct {
+	writers []io.Writer
+}
+
+// MultiWriterAll is like io.MultiWriter, but a failing writer does not stop
+// the write: every writer is attempted and all failures are joined into the
+// returned error.
+func MultiWriterAll(writers ...io.Writer) io.Writer {
+	all := make([]io.Writer, len(writers))
+	copy(all, writers)
+	return &allWriter{all}
+}
+
+func (t *allWriter) Write(p []byte) (int, error) {
+	_, errs := t.writeAll(p)
+	return len(p), errors.Join(errs...)
+}
+
+// writeAll writes p to every writer and returns how many succeeded along
+// with the failures.
+func (t *allWriter) writeAll(p []byte) (ok int, errs []error) {
+	for i, w := range t.writers {
+		n, err := w.Write(p)
+		if err == nil && n != len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("writer %d: %w", i, err))
+			continue
+		}
+		ok++
+	}
+	return ok, errs
+}
+
+// MultiWriteError is returned by ResilientMultiWriter when some of its
+// writers failed. Err joins the individual failures.
+type MultiWriteError struct {
+	Succeeded int
+	Total     int
+	Err       error
+}
+
+func (e *MultiWriteError) Error() string {
+	return fmt.Sprintf("%d of %d writers succeeded: %v", e.Succeeded, e.Total, e.Err)
+}
+
+func (e *MultiWriteError) Unwrap() error {
+	return e.Err
+}
+
+type resilientWriter struct {
+	allWriter
+}
+
+// ResilientMultiWriter writes to all ws like MultiWriterAll. If any of them
+// fail the error is a *MultiWriteError, which also reports how many of the
+// destinations did receive the data.
+func ResilientMultiWriter(ws ...io.Writer) io.Writer {
+	all := make([]io.Writer, len(ws))
+	copy(all, ws)
+	return &resilientWriter{allWriter{all}}
+}
+
+func (t *resilientWriter) Write(p []byte) (int, error) {
+	ok, errs := t.writeAll(p)
+	if len(errs) == 0 {
+		return len(p), nil
+	}
+	return len(p), &MultiWriteError{Succeeded: ok, Total: len(t.writers), Err: errors.Join(errs...)}
+}
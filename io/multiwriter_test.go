@@ -0,0 +1,77 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type failWriter struct {
+	err error
+}
+
+func (f failWriter) Write(p []byte) (int, error) {
+	return 0, f.err
+}
+
+func TestMultiWriterAll(t *testing.T) {
+	errDown := errors.New("sink down")
+	var a, b bytes.Buffer
+
+	w := MultiWriterAll(&a, failWriter{errDown}, &b)
+	n, err := w.Write([]byte("yooo"))
+
+	if n != 4 {
+		t.Errorf("got n %d, want 4", n)
+	}
+	if a.String() != "yooo" || b.String() != "yooo" {
+		t.Errorf("got %q and %q, want both writers to get the bytes", a.String(), b.String())
+	}
+	if !errors.Is(err, errDown) {
+		t.Errorf("got %v, want it to wrap %v", err, errDown)
+	}
+	if !strings.Contains(err.Error(), "writer 1") {
+		t.Errorf("got %q, want it to name writer 1", err)
+	}
+}
+
+func TestMultiWriterAllNoError(t *testing.T) {
+	var a bytes.Buffer
+	if _, err := MultiWriterAll(&a).Write([]byte("ok")); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+}
+
+func TestResilientMultiWriter(t *testing.T) {
+	errDown := errors.New("sink down")
+	var a, b bytes.Buffer
+
+	w := ResilientMultiWriter(&a, failWriter{errDown}, &b)
+	n, err := w.Write([]byte("yooo"))
+
+	if n != 4 {
+		t.Errorf("got n %d, want 4", n)
+	}
+	if a.String() != "yooo" || b.String() != "yooo" {
+		t.Errorf("got %q and %q, want both good writers to get the bytes", a.String(), b.String())
+	}
+
+	var mwe *MultiWriteError
+	if !errors.As(err, &mwe) {
+		t.Fatalf("got %T, want *MultiWriteError", err)
+	}
+	if mwe.Succeeded != 2 || mwe.Total != 3 {
+		t.Errorf("got %d of %d, want 2 of 3", mwe.Succeeded, mwe.Total)
+	}
+	if !errors.Is(err, errDown) {
+		t.Errorf("got %v, want it to wrap %v", err, errDown)
+	}
+}
+
+func TestResilientMultiWriterNoError(t *testing.T) {
+	var a, b bytes.Buffer
+	if _, err := ResilientMultiWriter(&a, &b).Write([]byte("ok")); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+}
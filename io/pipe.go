@@ -0,0 +1,123 @@
+package io
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrPipeTimeout is returned by pipes from PipeWithTimeout when the other
+// side did not act in time.
+var ErrPipeTimeout = errors.New("pipe: operation timed out")
+
+type timeoutPipe struct {
+	pr *io.PipeReader
+	pw *io.PipeWriter
+	d  time.Duration
+
+	expired atomic.Bool
+
+	mu      sync.Mutex
+	writing map[*watchdog]struct{} // watchdogs of the pending Writes
+}
+
+// watchdog breaks the pipe with ErrPipeTimeout unless stop is called
+// within d of the last arm.
+type watchdog struct {
+	t     *timeoutPipe
+	timer *time.Timer
+	done  bool // guarded by t.mu
+}
+
+func (t *timeoutPipe) watch() *watchdog {
+	w := &watchdog{t: t}
+	w.timer = time.AfterFunc(t.d, w.fire)
+	return w
+}
+
+func (w *watchdog) fire() {
+	w.t.mu.Lock()
+	defer w.t.mu.Unlock()
+
+	// the operation may have finished just before the timer went off
+	if w.done {
+		return
+	}
+	w.t.expired.Store(true)
+	w.t.pr.CloseWithError(ErrPipeTimeout)
+	w.t.pw.CloseWithError(ErrPipeTimeout)
+}
+
+func (w *watchdog) stop() {
+	w.t.mu.Lock()
+	w.done = true
+	w.t.mu.Unlock()
+	w.timer.Stop()
+}
+
+// wrap reports any failure after the watchdog fired as ErrPipeTimeout,
+// since io.Pipe itself would only say the pipe is closed.
+func (t *timeoutPipe) wrap(n int, err error) (int, error) {
+	if err != nil && t.expired.Load() {
+		err = ErrPipeTimeout
+	}
+	return n, err
+}
+
+type timeoutPipeReader struct{ *timeoutPipe }
+
+func (r timeoutPipeReader) Read(p []byte) (int, error) {
+	w := r.watch()
+	n, err := r.pr.Read(p)
+	w.stop()
+
+	if n > 0 {
+		// a pending Write made progress, so give them all another d.
+		// io.Pipe allows concurrent Writes, and which one the bytes came
+		// from is not known.
+		r.mu.Lock()
+		for wd := range r.writing {
+			wd.timer.Reset(r.d)
+		}
+		r.mu.Unlock()
+	}
+	return r.wrap(n, err)
+}
+
+func (r timeoutPipeReader) Close() error {
+	return r.pr.Close()
+}
+
+type timeoutPipeWriter struct{ *timeoutPipe }
+
+func (w timeoutPipeWriter) Write(p []byte) (int, error) {
+	wd := w.watch()
+	w.mu.Lock()
+	w.writing[wd] = struct{}{}
+	w.mu.Unlock()
+
+	n, err := w.pw.Write(p)
+	wd.stop()
+
+	w.mu.Lock()
+	delete(w.writing, wd)
+	w.mu.Unlock()
+	return w.wrap(n, err)
+}
+
+func (w timeoutPipeWriter) Close() error {
+	return w.pw.Close()
+}
+
+// PipeWithTimeout is io.Pipe where the other side must make progress on
+// every Read and Write within d: a Read has to receive some data, and a
+// Write has to see a Read take some of its bytes, every d. Otherwise the
+// pipe is broken and the pending and all later operations fail with
+// ErrPipeTimeout, instead of blocking forever.
+func PipeWithTimeout(d time.Duration) (io.ReadCloser, io.WriteCloser) {
+	pr, pw := io.Pipe()
+	t := &timeoutPipe{pr: pr, pw: pw, d: d, writing: make(map[*watchdog]struct{})}
+	return timeoutPipeReader{t}, timeoutPipeWriter{t}
+}
@@ -0,0 +1,112 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestPipeWithTimeoutNoReader(t *testing.T) {
+	_, w := PipeWithTimeout(20 * time.Millisecond)
+
+	_, err := w.Write([]byte("hello"))
+	if !errors.Is(err, ErrPipeTimeout) {
+		t.Errorf("got %v, want %v", err, ErrPipeTimeout)
+	}
+
+	// the pipe stays broken afterwards
+	if _, err := w.Write([]byte("again")); !errors.Is(err, ErrPipeTimeout) {
+		t.Errorf("got %v, want %v", err, ErrPipeTimeout)
+	}
+}
+
+func TestPipeWithTimeoutNoWriter(t *testing.T) {
+	r, _ := PipeWithTimeout(20 * time.Millisecond)
+
+	_, err := r.Read(make([]byte, 8))
+	if !errors.Is(err, ErrPipeTimeout) {
+		t.Errorf("got %v, want %v", err, ErrPipeTimeout)
+	}
+}
+
+func TestPipeWithTimeoutSlowReader(t *testing.T) {
+	r, w := PipeWithTimeout(50 * time.Millisecond)
+	data := bytes.Repeat([]byte("x"), 100)
+
+	// the whole Write takes about 500ms, far longer than d, but every
+	// byte arrives well within d of the previous one
+	done := make(chan error, 1)
+	go func() {
+		_, err := w.Write(data)
+		w.Close()
+		done <- err
+	}()
+
+	var got bytes.Buffer
+	buf := make([]byte, 1)
+	for {
+		n, err := r.Read(buf)
+		got.Write(buf[:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read: %v", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := <-done; err != nil {
+		t.Errorf("write: %v", err)
+	}
+	if !bytes.Equal(got.Bytes(), data) {
+		t.Errorf("got %d bytes, want %d", got.Len(), len(data))
+	}
+}
+
+func TestPipeWithTimeoutConcurrentWriters(t *testing.T) {
+	r, w := PipeWithTimeout(50 * time.Millisecond)
+
+	// a short and a long Write run at the same time; the reader keeps
+	// taking bytes, so neither may time out after the short one is done
+	done := make(chan error, 2)
+	for _, size := range []int{5, 60} {
+		go func(size int) {
+			_, err := w.Write(bytes.Repeat([]byte("y"), size))
+			done <- err
+		}(size)
+	}
+
+	buf := make([]byte, 1)
+	for i := 0; i < 65; i++ {
+		if _, err := r.Read(buf); err != nil {
+			t.Fatalf("read %d: %v", i, err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Errorf("write: %v", err)
+		}
+	}
+}
+
+func TestPipeWithTimeoutRoundTrip(t *testing.T) {
+	r, w := PipeWithTimeout(time.Second)
+
+	go func() {
+		w.Write([]byte("ping"))
+		w.Close()
+	}()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "ping" {
+		t.Errorf("got %q, want %q", got, "ping")
+	}
+}
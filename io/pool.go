@@ -0,0 +1,42 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// copyBufPool holds 32KB copy buffers, the same size io.Copy allocates.
+// Pointers are stored so that Put does not allocate.
+var copyBufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 32*1024)
+		return &b
+	},
+}
+
+// CopyPooled is io.Copy with a buffer borrowed from a package-level pool
+// instead of a freshly allocated one.
+func CopyPooled(dst io.Writer, src io.Reader) (int64, error) {
+	bp := copyBufPool.Get().(*[]byte)
+	defer copyBufPool.Put(bp)
+
+	return io.CopyBuffer(dst, src, *bp)
+}
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+// GetBuffer returns an empty bytes.Buffer from the package pool.
+func GetBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// PutBuffer resets b and returns it to the pool. b must not be used after.
+func PutBuffer(b *bytes.Buffer) {
+	b.Reset()
+	bufferPool.Put(b)
+}
@@ -0,0 +1,85 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+// onlyWriter hides bytes.Buffer's ReadFrom, so the copy has to go
+// through a buffer.
+type onlyWriter struct {
+	io.Writer
+}
+
+func TestCopyPooled(t *testing.T) {
+	input := strings.Repeat("yooo pooled ", 10000)
+
+	var dst bytes.Buffer
+	n, err := CopyPooled(onlyWriter{&dst}, strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(input)) || dst.String() != input {
+		t.Errorf("copied %d bytes, want %d", n, len(input))
+	}
+}
+
+func BenchmarkSmallCopies(b *testing.B) {
+	var benchmarks = []struct {
+		name string
+		copy func(io.Writer, io.Reader) (int64, error)
+	}{
+		{"io.Copy", io.Copy},
+		{"CopyPooled", CopyPooled},
+	}
+
+	src := []byte("yooo small copy")
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// plain readers and writers force a copy buffer
+				bm.copy(onlyWriter{io.Discard}, io.LimitReader(bytes.NewReader(src), 64))
+			}
+		})
+	}
+}
+
+func TestBufferPoolStartsEmpty(t *testing.T) {
+	b := GetBuffer()
+	b.WriteString("leftover")
+	PutBuffer(b)
+
+	// the pool may or may not hand back the same buffer; either way it
+	// must be empty
+	for i := 0; i < 10; i++ {
+		b := GetBuffer()
+		if b.Len() != 0 {
+			t.Fatalf("got buffer holding %q, want empty", b.String())
+		}
+		PutBuffer(b)
+	}
+}
+
+func BenchmarkBuffer(b *testing.B) {
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := new(bytes.Buffer)
+			buf.WriteString("yooo buffer pool")
+			io.Copy(io.Discard, buf)
+		}
+	})
+
+	b.Run("pool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf := GetBuffer()
+			buf.WriteString("yooo buffer pool")
+			io.Copy(io.Discard, buf)
+			PutBuffer(buf)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package io
+
+import "io"
+
+// ProgressWriter passes writes on to W and counts the bytes. When Total is
+// known (greater than zero) and OnProgress is set, it is called after every
+// write with the percentage of Total written so far, 100 once done.
+type ProgressWriter struct {
+	W          io.Writer
+	Total      int64
+	OnProgress func(pct float64)
+
+	written int64
+}
+
+func (p *ProgressWriter) Write(b []byte) (int, error) {
+	n, err := p.W.Write(b)
+	p.written += int64(n)
+
+	if p.Total > 0 && p.OnProgress != nil {
+		p.OnProgress(float64(p.written) / float64(p.Total) * 100)
+	}
+	return n, err
+}
+
+// Written returns the number of bytes written so far.
+func (p *ProgressWriter) Written() int64 {
+	return p.written
+}
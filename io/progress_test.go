@@ -0,0 +1,60 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestProgressWriter(t *testing.T) {
+	const size = 10000
+
+	var dst bytes.Buffer
+	var pcts []float64
+	pw := &ProgressWriter{
+		W:          &dst,
+		Total:      size,
+		OnProgress: func(pct float64) { pcts = append(pcts, pct) },
+	}
+
+	// a small buffer makes io.Copy write in several steps
+	_, err := io.CopyBuffer(pw, onlyReader{strings.NewReader(strings.Repeat("p", size))}, make([]byte, 1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pcts) < 2 {
+		t.Fatalf("got %d callbacks, want several", len(pcts))
+	}
+	for i := 1; i < len(pcts); i++ {
+		if pcts[i] < pcts[i-1] {
+			t.Errorf("progress went back from %g to %g", pcts[i-1], pcts[i])
+		}
+	}
+	if last := pcts[len(pcts)-1]; last != 100 {
+		t.Errorf("got final %g%%, want 100%%", last)
+	}
+	if pw.Written() != size || dst.Len() != size {
+		t.Errorf("got %d written, want %d", pw.Written(), size)
+	}
+}
+
+func TestProgressWriterUnknownTotal(t *testing.T) {
+	called := false
+	pw := &ProgressWriter{W: io.Discard, OnProgress: func(float64) { called = true }}
+	pw.Write([]byte("yooo"))
+
+	if called {
+		t.Errorf("got a callback, want none without Total")
+	}
+	if pw.Written() != 4 {
+		t.Errorf("got %d, want 4", pw.Written())
+	}
+}
+
+// onlyReader hides any WriterTo of the wrapped reader, so io.Copy has to
+// go through the buffer.
+type onlyReader struct {
+	io.Reader
+}
@@ -0,0 +1,96 @@
+package io
+
+import (
+	"io"
+	"time"
+)
+
+// bucket is a token bucket holding at most rate tokens, one token per byte.
+// It starts empty so the very first bytes are paced as well.
+type bucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(bytesPerSec int) *bucket {
+	return &bucket{rate: float64(bytesPerSec), last: time.Now()}
+}
+
+// take blocks until n tokens are available and consumes them.
+func (b *bucket) take(n int) {
+	for {
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+		b.last = now
+
+		if b.tokens >= float64(n) {
+			b.tokens -= float64(n)
+			return
+		}
+
+		missing := float64(n) - b.tokens
+		time.Sleep(time.Duration(missing / b.rate * float64(time.Second)))
+	}
+}
+
+type rateLimitReader struct {
+	r io.Reader
+	b *bucket
+}
+
+// RateLimitReader returns a reader that reads from r no faster than
+// bytesPerSec. A non-positive rate returns r unchanged.
+func RateLimitReader(r io.Reader, bytesPerSec int) io.Reader {
+	if bytesPerSec <= 0 {
+		return r
+	}
+	return &rateLimitReader{r: r, b: newBucket(bytesPerSec)}
+}
+
+func (l *rateLimitReader) Read(p []byte) (int, error) {
+	// never ask for more than one second's worth of bytes at once
+	if len(p) > int(l.b.rate) {
+		p = p[:int(l.b.rate)]
+	}
+
+	n, err := l.r.Read(p)
+	if n > 0 {
+		l.b.take(n)
+	}
+	return n, err
+}
+
+type throttledWriter struct {
+	w io.Writer
+	b *bucket
+}
+
+// ThrottledWriter returns a writer that passes everything on to w, but no
+// faster than bytesPerSec. A non-positive rate returns w unchanged.
+func ThrottledWriter(w io.Writer, bytesPerSec int) io.Writer {
+	if bytesPerSec <= 0 {
+		return w
+	}
+	return &throttledWriter{w: w, b: newBucket(bytesPerSec)}
+}
+
+func (t *throttledWriter) Write(p []byte) (int, error) {
+	var total int
+	for len(p) > 0 {
+		// write at most one second's worth of bytes at once
+		chunk := p[:min(len(p), int(t.b.rate))]
+		t.b.take(len(chunk))
+
+		n, err := t.w.Write(chunk)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		p = p[n:]
+	}
+	return total, nil
+}
@@ -0,0 +1,84 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRateLimitReader(t *testing.T) {
+	const size, rate = 300, 1000
+
+	src := bytes.Repeat([]byte("a"), size)
+	var dst bytes.Buffer
+
+	start := time.Now()
+	n, err := io.Copy(&dst, RateLimitReader(bytes.NewReader(src), rate))
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != size || !bytes.Equal(dst.Bytes(), src) {
+		t.Fatalf("copied %d bytes, want %d", n, size)
+	}
+
+	want := time.Duration(size) * time.Second / rate
+	if elapsed < want-10*time.Millisecond {
+		t.Errorf("took %v, want at least %v", elapsed, want)
+	}
+	if elapsed > want+500*time.Millisecond {
+		t.Errorf("took %v, want about %v", elapsed, want)
+	}
+}
+
+func TestRateLimitReaderNoLimit(t *testing.T) {
+	r := bytes.NewReader(nil)
+	if got := RateLimitReader(r, 0); got != r {
+		t.Errorf("got %T, want the reader unchanged", got)
+	}
+}
+
+func TestThrottledWriter(t *testing.T) {
+	const size, rate = 300, 1000
+
+	src := bytes.Repeat([]byte("b"), size)
+	var dst bytes.Buffer
+	w := ThrottledWriter(&dst, rate)
+
+	start := time.Now()
+	// the bucket paces across writes, not just within one
+	var n int
+	for _, chunk := range [][]byte{src[:10], src[10:250], src[250:]} {
+		m, err := w.Write(chunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n += m
+	}
+	elapsed := time.Since(start)
+
+	if n != size || !bytes.Equal(dst.Bytes(), src) {
+		t.Fatalf("wrote %d bytes, want %d", n, size)
+	}
+
+	want := time.Duration(size) * time.Second / rate
+	if elapsed < want-10*time.Millisecond {
+		t.Errorf("took %v, want at least %v", elapsed, want)
+	}
+	if elapsed > want+500*time.Millisecond {
+		t.Errorf("took %v, want about %v", elapsed, want)
+	}
+}
+
+func TestThrottledWriterLargeWrite(t *testing.T) {
+	// a single Write bigger than the rate is split, not rejected
+	const size, rate = 25, 20
+
+	var dst bytes.Buffer
+	n, err := ThrottledWriter(&dst, rate).Write(bytes.Repeat([]byte("c"), size))
+	if err != nil || n != size || dst.Len() != size {
+		t.Errorf("got %d, %v with %d bytes written, want %d", n, err, dst.Len(), size)
+	}
+}
@@ -0,0 +1,88 @@
+package io
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrRingFull is returned by RingBuffer.Write when not all of p fit.
+	ErrRingFull = errors.New("ring buffer: full")
+	// ErrRingEmpty is returned by RingBuffer.Read when there is nothing
+	// to read yet.
+	ErrRingEmpty = errors.New("ring buffer: empty")
+)
+
+// RingBuffer is a fixed-size FIFO byte buffer. Writes never grow it: they
+// store what fits and report ErrRingFull for the rest, so a producer has to
+// wait for the consumer to catch up. Neither side blocks. It is safe for one
+// goroutine to write while another reads.
+type RingBuffer struct {
+	mu   sync.Mutex
+	buf  []byte
+	r    int // next index to read
+	size int // number of unread bytes
+}
+
+// NewRingBuffer returns an empty RingBuffer holding up to capacity bytes.
+// With a capacity of zero every non-empty Write fails with ErrRingFull and
+// every non-empty Read with ErrRingEmpty.
+func NewRingBuffer(capacity int) *RingBuffer {
+	return &RingBuffer{buf: make([]byte, capacity)}
+}
+
+// Write stores as much of p as there is room for. If that is less than all
+// of p it returns the stored count and ErrRingFull.
+func (b *RingBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var n int
+	for n < len(p) && b.size < len(b.buf) {
+		w := (b.r + b.size) % len(b.buf)
+		// copy up to the end of the free region or of the backing array
+		end := len(b.buf)
+		if w < b.r {
+			end = b.r
+		}
+		c := copy(b.buf[w:end], p[n:])
+		n += c
+		b.size += c
+	}
+
+	if n < len(p) {
+		return n, ErrRingFull
+	}
+	return n, nil
+}
+
+// Read takes up to len(p) of the oldest bytes. It returns ErrRingEmpty if
+// there is nothing to read.
+func (b *RingBuffer) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.size == 0 {
+		if len(p) == 0 {
+			return 0, nil
+		}
+		return 0, ErrRingEmpty
+	}
+
+	var n int
+	for n < len(p) && b.size > 0 {
+		end := min(b.r+b.size, len(b.buf))
+		c := copy(p[n:], b.buf[b.r:end])
+		n += c
+		b.size -= c
+		b.r = (b.r + c) % len(b.buf)
+	}
+	return n, nil
+}
+
+// Len returns the number of unread bytes.
+func (b *RingBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
@@ -0,0 +1,85 @@
+package io
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRingBufferWrapAround(t *testing.T) {
+	b := NewRingBuffer(8)
+	p := make([]byte, 8)
+
+	b.Write([]byte("abcdef"))
+	if n, _ := b.Read(p[:4]); string(p[:n]) != "abcd" {
+		t.Fatalf("got %q, want %q", p[:n], "abcd")
+	}
+
+	// "ghijkl" wraps past the end of the backing array
+	if n, err := b.Write([]byte("ghijkl")); n != 6 || err != nil {
+		t.Fatalf("got %d, %v, want 6, nil", n, err)
+	}
+	if ans := b.Len(); ans != 8 {
+		t.Errorf("got len %d, want 8", ans)
+	}
+
+	n, err := b.Read(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(p[:n]) != "efghijkl" {
+		t.Errorf("got %q, want %q", p[:n], "efghijkl")
+	}
+}
+
+func TestRingBufferPartialReads(t *testing.T) {
+	b := NewRingBuffer(16)
+	b.Write([]byte("yooo ring"))
+
+	var got bytes.Buffer
+	p := make([]byte, 2)
+	for {
+		n, err := b.Read(p)
+		if err == ErrRingEmpty {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got.Write(p[:n])
+	}
+	if got.String() != "yooo ring" {
+		t.Errorf("got %q, want %q", got.String(), "yooo ring")
+	}
+}
+
+func TestRingBufferFull(t *testing.T) {
+	b := NewRingBuffer(4)
+
+	n, err := b.Write([]byte("abcdef"))
+	if n != 4 || err != ErrRingFull {
+		t.Errorf("got %d, %v, want 4, %v", n, err, ErrRingFull)
+	}
+	if n, err := b.Write([]byte("x")); n != 0 || err != ErrRingFull {
+		t.Errorf("got %d, %v, want 0, %v", n, err, ErrRingFull)
+	}
+
+	// once the consumer catches up there is room again
+	p := make([]byte, 2)
+	b.Read(p)
+	if n, err := b.Write([]byte("ef")); n != 2 || err != nil {
+		t.Errorf("got %d, %v, want 2, nil", n, err)
+	}
+
+	p = make([]byte, 4)
+	n, _ = b.Read(p)
+	if string(p[:n]) != "cdef" {
+		t.Errorf("got %q, want %q", p[:n], "cdef")
+	}
+}
+
+func TestRingBufferEmpty(t *testing.T) {
+	b := NewRingBuffer(4)
+	if n, err := b.Read(make([]byte, 1)); n != 0 || err != ErrRingEmpty {
+		t.Errorf("got %d, %v, want 0, %v", n, err, ErrRingEmpty)
+	}
+}
@@ -0,0 +1,15 @@
+package io
+
+import "io"
+
+// ReadSection reads n bytes of r starting at offset off. If the section runs
+// past the end of r, only the bytes that are available are returned. If off
+// is at or past the end of r, there is nothing to return and the error is
+// io.EOF.
+func ReadSection(r io.ReaderAt, off, n int64) ([]byte, error) {
+	b, err := io.ReadAll(io.NewSectionReader(r, off, n))
+	if err == nil && len(b) == 0 && n > 0 {
+		return nil, io.EOF
+	}
+	return b, err
+}
@@ -0,0 +1,52 @@
+package io
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestReadSection(t *testing.T) {
+	r := bytes.NewReader([]byte("0123456789"))
+
+	var tests = []struct {
+		off, n int64
+		want   string
+	}{
+		{0, 3, "012"},
+		{4, 3, "456"},
+		{0, 10, "0123456789"},
+		{7, 10, "789"},
+		{9, 1, "9"},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%d", tt.off, tt.n)
+		t.Run(testname, func(t *testing.T) {
+			b, err := ReadSection(r, tt.off, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("got %q, want %q", b, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadSectionPastEnd(t *testing.T) {
+	r := bytes.NewReader([]byte("0123456789"))
+
+	for _, off := range []int64{10, 11, 100} {
+		t.Run(fmt.Sprint(off), func(t *testing.T) {
+			b, err := ReadSection(r, off, 3)
+			if err != io.EOF {
+				t.Errorf("got %v, want %v", err, io.EOF)
+			}
+			if len(b) != 0 {
+				t.Errorf("got %q, want nothing", b)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package io
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+)
+
+type splitWriter struct {
+	dir      string
+	prefix   string
+	maxBytes int64
+
+	f       *os.File
+	index   int // number of the next file to create
+	written int64
+	closed  bool
+}
+
+// NewSplitWriter returns a writer that spreads its output over sequentially
+// numbered files in dir (prefix.000, prefix.001, ...), each holding at most
+// maxBytes. A non-positive maxBytes never rolls over. Files are created on
+// demand; Close closes the current one, after which Write fails with
+// os.ErrClosed.
+func NewSplitWriter(dir, prefix string, maxBytes int64) io.WriteCloser {
+	if maxBytes <= 0 {
+		maxBytes = math.MaxInt64
+	}
+	return &splitWriter{dir: dir, prefix: prefix, maxBytes: maxBytes}
+}
+
+func (s *splitWriter) Write(p []byte) (int, error) {
+	if s.closed {
+		return 0, os.ErrClosed
+	}
+
+	var total int
+	for len(p) > 0 {
+		if s.f == nil || s.written >= s.maxBytes {
+			if err := s.rotate(); err != nil {
+				return total, err
+			}
+		}
+
+		chunk := p
+		if room := s.maxBytes - s.written; int64(len(chunk)) > room {
+			chunk = chunk[:room]
+		}
+
+		n, err := s.f.Write(chunk)
+		total += n
+		s.written += int64(n)
+		if err != nil {
+			return total, err
+		}
+		p = p[n:]
+	}
+	return total, nil
+}
+
+func (s *splitWriter) rotate() error {
+	if s.f != nil {
+		if err := s.f.Close(); err != nil {
+			return err
+		}
+		s.f = nil
+	}
+
+	name := filepath.Join(s.dir, fmt.Sprintf("%s.%03d", s.prefix, s.index))
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+
+	s.f = f
+	s.index++
+	s.written = 0
+	return nil
+}
+
+func (s *splitWriter) Close() error {
+	s.closed = true
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
@@ -0,0 +1,67 @@
+package io
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSplitWriter(t *testing.T) {
+	const max = 100
+	dir := t.TempDir()
+
+	w := NewSplitWriter(dir, "log", max)
+	// write 2.5x the rotation size in uneven pieces
+	data := bytes.Repeat([]byte("0123456789"), 25)
+	for _, chunk := range [][]byte{data[:30], data[30:170], data[170:]} {
+		if _, err := w.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d files, want 3", len(entries))
+	}
+
+	var joined []byte
+	for i, want := range []int{100, 100, 50} {
+		name := fmt.Sprintf("log.%03d", i)
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(b) != want {
+			t.Errorf("%s: got %d bytes, want %d", name, len(b), want)
+		}
+		joined = append(joined, b...)
+	}
+	if !bytes.Equal(joined, data) {
+		t.Error("files do not hold the written data in order")
+	}
+}
+
+func TestSplitWriterWriteAfterClose(t *testing.T) {
+	dir := t.TempDir()
+
+	w := NewSplitWriter(dir, "log", 10)
+	w.Write([]byte("yooo"))
+	w.Close()
+
+	if _, err := w.Write([]byte("after close")); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("got %v, want %v", err, os.ErrClosed)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("got %d files, want 1", len(entries))
+	}
+}
@@ -0,0 +1,18 @@
+package io
+
+import (
+	"io"
+	"os"
+)
+
+// TeeToFile returns a reader that reads from r and writes an exact copy of
+// everything read to the file at path. The returned func closes the file and
+// must be called once the reader is done.
+func TeeToFile(r io.Reader, path string) (io.Reader, func() error, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return io.TeeReader(r, f), f.Close, nil
+}
@@ -0,0 +1,45 @@
+package io
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTeeToFile(t *testing.T) {
+	input := strings.Repeat("yooo tee\n", 100)
+	path := filepath.Join(t.TempDir(), "copy.txt")
+
+	r, closeFile, err := TeeToFile(strings.NewReader(input), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := closeFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != input {
+		t.Error("reader did not return the input")
+	}
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(saved) != input {
+		t.Errorf("file holds %d bytes, want the %d input bytes", len(saved), len(input))
+	}
+}
+
+func TestTeeToFileBadPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "copy.txt")
+	if _, _, err := TeeToFile(strings.NewReader(""), path); err == nil {
+		t.Error("got nil error for a path in a missing directory")
+	}
+}
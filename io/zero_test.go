@@ -0,0 +1,59 @@
+package io
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestZeroReader(t *testing.T) {
+	var tests = []int64{0, 1, 100, 64 * 1024}
+
+	for _, size := range tests {
+		b, err := io.ReadAll(ZeroReader(size))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int64(len(b)) != size || !bytes.Equal(b, make([]byte, size)) {
+			t.Errorf("got %d bytes, want %d zero bytes", len(b), size)
+		}
+	}
+}
+
+func TestZeroReaderIntoDiscard(t *testing.T) {
+	n, err := io.Copy(io.Discard, ZeroReader(1<<20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1<<20 {
+		t.Errorf("got %d, want %d", n, 1<<20)
+	}
+}
+
+func BenchmarkCopy(b *testing.B) {
+	const size = 1 << 20
+
+	var benchmarks = []struct {
+		name string
+		copy func(io.Writer, io.Reader) (int64, error)
+	}{
+		{"io.Copy", io.Copy},
+		{"CopyPooled", CopyPooled},
+		{"CopyWithChecksum", func(w io.Writer, r io.Reader) (int64, error) {
+			n, _, err := CopyWithChecksum(w, r)
+			return n, err
+		}},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.SetBytes(size)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := bm.copy(io.Discard, ZeroReader(size)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
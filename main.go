@@ -2,7 +2,13 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strings"
 	"sync"
+
+	"tutorial/io"
+	"tutorial/simple_buffer"
 )
 
 var wg = sync.WaitGroup{}
@@ -11,7 +17,50 @@ func log() {
 	fmt.Println("log")
 }
 
+// defaultCommand is run when no subcommand is given.
+const defaultCommand = "wg"
+
+// commands maps each subcommand to the demo it runs.
+var commands = map[string]func(){
+	"bufio": simple_buffer.SimpleBuffer,
+	"io":    io.Io,
+	"wg":    waitGroup,
+}
+
 func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run dispatches args[0] to its demo and returns the exit code. Without
+// arguments it runs defaultCommand, as main did before it had subcommands.
+func run(args []string) int {
+	if len(args) == 0 {
+		args = []string{defaultCommand}
+	}
+
+	demo, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", args[0])
+		usage()
+		return 2
+	}
+
+	demo()
+	return 0
+}
+
+func usage() {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage: tutorial [command]")
+	fmt.Fprintf(os.Stderr, "commands: %s (default %s)\n", strings.Join(names, ", "), defaultCommand)
+}
+
+func waitGroup() {
 	wg.Add(1)
 
 	go func() {
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIntMinBasic(t *testing.T) {
+	ans := IntMin(2, -2)
+	if ans != -2 {
+		t.Errorf("IntMin(2, -2) = %d; want -2", ans)
+	}
+}
+
+func TestIntMinTableDriven(t *testing.T) {
+	var tests = []struct {
+		a, b int
+		want int
+	}{
+		{0, 1, 0},
+		{1, 0, 0},
+		{2, -2, -2},
+		{0, -1, -1},
+		{-1, 0, -1},
+		{10, 20, 10},
+		{5, 5, 5},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%d", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			ans := IntMin(tt.a, tt.b)
+			if ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkIntMin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		IntMin(1, 2)
+	}
+}
+
+func TestIntMaxTableDriven(t *testing.T) {
+	var tests = []struct {
+		a, b int
+		want int
+	}{
+		{0, 1, 1},
+		{1, 0, 1},
+		{2, -2, 2},
+		{0, -1, 0},
+		{-1, 0, 0},
+		{-5, -3, -3},
+		{7, 7, 7},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%d", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			ans := IntMax(tt.a, tt.b)
+			if ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	var tests = []struct {
+		args []string
+		want int
+	}{
+		{[]string{"bufio"}, 0},
+		{[]string{"io"}, 0},
+		{[]string{"wg"}, 0},
+		{nil, 0},
+		{[]string{"nope"}, 2},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprint(tt.args)
+		t.Run(testname, func(t *testing.T) {
+			if ans := run(tt.args); ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunDefault(t *testing.T) {
+	saved := commands[defaultCommand]
+	defer func() { commands[defaultCommand] = saved }()
+
+	called := false
+	commands[defaultCommand] = func() { called = true }
+
+	if ans := run(nil); ans != 0 || !called {
+		t.Errorf("got %d, called %v, want 0 and the %s demo to run", ans, called, defaultCommand)
+	}
+}
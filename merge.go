@@ -0,0 +1,27 @@
+package main
+
+import "sync"
+
+// Merge fans the values of all chans into one channel, which is closed
+// once every input channel has been closed and drained.
+func Merge[T any](chans ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for v := range ch {
+				out <- v
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
+}
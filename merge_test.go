@@ -0,0 +1,52 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	send := func(vals ...int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			defer close(ch)
+			for _, v := range vals {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	out := Merge(send(1, 2, 3), send(10, 20), send(100, 200, 300, 400))
+
+	seen := map[int]int{}
+	timeout := time.After(time.Second)
+	for done := false; !done; {
+		select {
+		case v, ok := <-out:
+			if !ok {
+				done = true
+				break
+			}
+			seen[v]++
+		case <-timeout:
+			t.Fatal("output channel was not closed")
+		}
+	}
+
+	want := []int{1, 2, 3, 10, 20, 100, 200, 300, 400}
+	if len(seen) != len(want) {
+		t.Errorf("got %d distinct values, want %d", len(seen), len(want))
+	}
+	for _, v := range want {
+		if seen[v] != 1 {
+			t.Errorf("value %d: got %d times, want 1", v, seen[v])
+		}
+	}
+}
+
+func TestMergeNone(t *testing.T) {
+	if _, ok := <-Merge[int](); ok {
+		t.Errorf("got a value, want a closed channel")
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"cmp"
+	"errors"
+)
+
+// ErrNoValues is returned by MinN and MaxN when called without arguments.
+var ErrNoValues = errors.New("no values given")
+
+// Min returns the smaller of a and b. For floats, a NaN argument makes the
+// result NaN, matching the builtin min.
+func Min[T cmp.Ordered](a, b T) T {
+	// a != a only holds for NaN
+	if a < b || a != a {
+		return a
+	}
+	return b
+}
+
+// IntMin returns the smaller of a and b.
+func IntMin(a, b int) int {
+	return Min(a, b)
+}
+
+// Max returns the larger of a and b. For floats, a NaN argument makes the
+// result NaN, matching the builtin max.
+func Max[T cmp.Ordered](a, b T) T {
+	if a > b || a != a {
+		return a
+	}
+	return b
+}
+
+// IntMax returns the larger of a and b.
+func IntMax(a, b int) int {
+	return Max(a, b)
+}
+
+// MinN returns the smallest of vals, or ErrNoValues if vals is empty.
+func MinN[T cmp.Ordered](vals ...T) (T, error) {
+	if len(vals) == 0 {
+		var zero T
+		return zero, ErrNoValues
+	}
+
+	m := vals[0]
+	for _, v := range vals[1:] {
+		m = Min(m, v)
+	}
+	return m, nil
+}
+
+// MaxN returns the largest of vals, or ErrNoValues if vals is empty.
+func MaxN[T cmp.Ordered](vals ...T) (T, error) {
+	if len(vals) == 0 {
+		var zero T
+		return zero, ErrNoValues
+	}
+
+	m := vals[0]
+	for _, v := range vals[1:] {
+		m = Max(m, v)
+	}
+	return m, nil
+}
+
+// Clamp bounds v to the range [lo, hi]. If lo > hi the bounds are swapped,
+// so Clamp(v, 10, 0) is the same as Clamp(v, 0, 10).
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return Max(lo, Min(v, hi))
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMinInt(t *testing.T) {
+	var tests = []struct {
+		a, b int
+		want int
+	}{
+		{0, 1, 0},
+		{1, 0, 0},
+		{-3, 2, -3},
+		{4, 4, 4},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%d", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Min(tt.a, tt.b); ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinFloat(t *testing.T) {
+	var tests = []struct {
+		a, b float64
+		want float64
+	}{
+		{0.5, 1.5, 0.5},
+		{-0.1, -0.2, -0.2},
+		{math.Inf(-1), 0, math.Inf(-1)},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%g,%g", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Min(tt.a, tt.b); ans != tt.want {
+				t.Errorf("got %g, want %g", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinNaN(t *testing.T) {
+	nan := math.NaN()
+
+	// NaN wins no matter which side it is on, like the builtin min
+	for _, ans := range []float64{Min(nan, 1), Min(1, nan), Min(nan, nan)} {
+		if !math.IsNaN(ans) {
+			t.Errorf("got %g, want NaN", ans)
+		}
+	}
+}
+
+func TestMinString(t *testing.T) {
+	var tests = []struct {
+		a, b string
+		want string
+	}{
+		{"apple", "banana", "apple"},
+		{"b", "a", "a"},
+		{"", "a", ""},
+		{"go", "go", "go"},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%q,%q", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Min(tt.a, tt.b); ans != tt.want {
+				t.Errorf("got %q, want %q", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxTableDriven(t *testing.T) {
+	var tests = []struct {
+		a, b float64
+		want float64
+	}{
+		{0, 1, 1},
+		{1, 0, 1},
+		{-2.5, -3.5, -2.5},
+		{-1, 0, 0},
+		{4.2, 4.2, 4.2},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%g,%g", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Max(tt.a, tt.b); ans != tt.want {
+				t.Errorf("got %g, want %g", ans, tt.want)
+			}
+		})
+	}
+
+	if ans := Max("apple", "banana"); ans != "banana" {
+		t.Errorf("got %q, want %q", ans, "banana")
+	}
+	if ans := Max(math.NaN(), 1); !math.IsNaN(ans) {
+		t.Errorf("got %g, want NaN", ans)
+	}
+}
+
+func TestMinMaxN(t *testing.T) {
+	var tests = []struct {
+		vals     []int
+		min, max int
+	}{
+		{[]int{7}, 7, 7},
+		{[]int{3, 1, 2}, 1, 3},
+		{[]int{-5, 10, 0, 10, -5}, -5, 10},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprint(tt.vals)
+		t.Run(testname, func(t *testing.T) {
+			if ans, err := MinN(tt.vals...); err != nil || ans != tt.min {
+				t.Errorf("MinN: got %d, %v, want %d", ans, err, tt.min)
+			}
+			if ans, err := MaxN(tt.vals...); err != nil || ans != tt.max {
+				t.Errorf("MaxN: got %d, %v, want %d", ans, err, tt.max)
+			}
+		})
+	}
+}
+
+func TestMinMaxNEmpty(t *testing.T) {
+	if ans, err := MinN[int](); err != ErrNoValues || ans != 0 {
+		t.Errorf("MinN: got %d, %v, want 0, %v", ans, err, ErrNoValues)
+	}
+	if ans, err := MaxN[string](); err != ErrNoValues || ans != "" {
+		t.Errorf("MaxN: got %q, %v, want \"\", %v", ans, err, ErrNoValues)
+	}
+}
+
+func TestClampInt(t *testing.T) {
+	var tests = []struct {
+		v, lo, hi int
+		want      int
+	}{
+		{5, 0, 10, 5},
+		{-1, 0, 10, 0},
+		{11, 0, 10, 10},
+		{0, 0, 10, 0},
+		{10, 0, 10, 10},
+		// lo > hi: the bounds are swapped
+		{5, 10, 0, 5},
+		{-1, 10, 0, 0},
+		{11, 10, 0, 10},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,[%d,%d]", tt.v, tt.lo, tt.hi)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Clamp(tt.v, tt.lo, tt.hi); ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestClampFloat(t *testing.T) {
+	var tests = []struct {
+		v, lo, hi float64
+		want      float64
+	}{
+		{0.5, 0, 1, 0.5},
+		{-0.1, 0, 1, 0},
+		{1.5, 0, 1, 1},
+		{1.5, 1, 0, 1},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%g,[%g,%g]", tt.v, tt.lo, tt.hi)
+		t.Run(testname, func(t *testing.T) {
+			if ans := Clamp(tt.v, tt.lo, tt.hi); ans != tt.want {
+				t.Errorf("got %g, want %g", ans, tt.want)
+			}
+		})
+	}
+}
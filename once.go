@@ -0,0 +1,28 @@
+package main
+
+import "sync"
+
+// KeyedOnce is a set of sync.Once values, one per key. The zero value is
+// ready to use.
+type KeyedOnce struct {
+	mu   sync.Mutex
+	once map[string]*sync.Once
+}
+
+// Do calls fn if and only if Do is being called for key for the first
+// time. Like sync.Once.Do, concurrent callers for the same key block until
+// fn has returned. Calls for different keys do not wait for each other.
+func (k *KeyedOnce) Do(key string, fn func()) {
+	k.mu.Lock()
+	if k.once == nil {
+		k.once = make(map[string]*sync.Once)
+	}
+	o, ok := k.once[key]
+	if !ok {
+		o = new(sync.Once)
+		k.once[key] = o
+	}
+	k.mu.Unlock()
+
+	o.Do(fn)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestKeyedOnce(t *testing.T) {
+	var k KeyedOnce
+	var calls [3]atomic.Int64
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		for key := range calls {
+			key := key
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				k.Do(fmt.Sprint("key", key), func() {
+					calls[key].Add(1)
+				})
+			}()
+		}
+	}
+	wg.Wait()
+
+	for key := range calls {
+		if ans := calls[key].Load(); ans != 1 {
+			t.Errorf("key%d: got %d calls, want 1", key, ans)
+		}
+	}
+}
+
+func TestKeyedOnceWaitsForFn(t *testing.T) {
+	var k KeyedOnce
+	var ready atomic.Bool
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			k.Do("db", func() { ready.Store(true) })
+			// every caller returns only once fn has finished
+			if !ready.Load() {
+				t.Errorf("Do returned before fn finished")
+			}
+		}()
+	}
+	wg.Wait()
+}
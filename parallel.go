@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"sync"
+)
+
+// ParallelMap applies f to every element of in using up to workers
+// goroutines. The results are in the same order as in, no matter in which
+// order the calls finish.
+//
+// If f panics the other calls still run, and once they are done
+// ParallelMap panics in the caller's goroutine with a *PanicError for the
+// first panic.
+func ParallelMap[T, R any](in []T, workers int, f func(T) R) []R {
+	var p firstPanic
+	out := make([]R, len(in))
+	forEachIndex(len(in), workers, func(i int) {
+		p.call(func() {
+			out[i] = f(in[i])
+		})
+	})
+	p.repanic()
+	return out
+}
+
+// MapConcurrent applies fn to every input using up to workers goroutines
+// and returns the results in input order. All inputs are processed even if
+// some fail; the errors are joined. A panic in fn is recovered and reported
+// as a *PanicError for that input.
+func MapConcurrent[T, R any](inputs []T, workers int, fn func(T) (R, error)) ([]R, error) {
+	out := make([]R, len(inputs))
+	errs := make([]error, len(inputs))
+	forEachIndex(len(inputs), workers, func(i int) {
+		errs[i] = safeCall(func() (err error) {
+			out[i], err = fn(inputs[i])
+			return err
+		})
+	})
+	return out, errors.Join(errs...)
+}
+
+// forEachIndex calls f for every index in [0, n) on up to workers
+// goroutines and waits for all calls to return. Callers write each result
+// into its own slot, so no locking is needed.
+func forEachIndex(n, workers int, f func(i int)) {
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < IntMax(workers, 1); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				f(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+}
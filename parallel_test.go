@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParallelMapOrder(t *testing.T) {
+	in := make([]int, 20)
+	for i := range in {
+		in[i] = i
+	}
+
+	for _, workers := range []int{1, 4, 20} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			// earlier elements sleep longer, so they finish last
+			out := ParallelMap(in, workers, func(v int) string {
+				time.Sleep(time.Duration(len(in)-v) * time.Millisecond)
+				return fmt.Sprint(v * v)
+			})
+
+			if len(out) != len(in) {
+				t.Fatalf("got %d results, want %d", len(out), len(in))
+			}
+			for i, v := range in {
+				if want := fmt.Sprint(v * v); out[i] != want {
+					t.Errorf("out[%d]: got %s, want %s", i, out[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestParallelMapEmpty(t *testing.T) {
+	out := ParallelMap(nil, 4, func(v int) int { return v })
+	if len(out) != 0 {
+		t.Errorf("got %v, want empty", out)
+	}
+}
+
+func TestMapConcurrentOrder(t *testing.T) {
+	in := []int{5, 1, 4, 2, 3, 0}
+
+	out, err := MapConcurrent(in, 3, func(v int) (string, error) {
+		time.Sleep(time.Duration(v) * 2 * time.Millisecond)
+		return fmt.Sprint(v * 10), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ans := fmt.Sprint(out); ans != "[50 10 40 20 30 0]" {
+		t.Errorf("got %s, want %s", ans, "[50 10 40 20 30 0]")
+	}
+}
+
+func TestMapConcurrentError(t *testing.T) {
+	errOdd := errors.New("odd input")
+
+	out, err := MapConcurrent([]int{1, 2, 3, 4}, 2, func(v int) (int, error) {
+		if v%2 == 1 {
+			return 0, errOdd
+		}
+		return v * v, nil
+	})
+	if !errors.Is(err, errOdd) {
+		t.Errorf("got %v, want %v", err, errOdd)
+	}
+	// the inputs that did succeed are still in their slots
+	if ans := fmt.Sprint(out); ans != "[0 4 0 16]" {
+		t.Errorf("got %s, want %s", ans, "[0 4 0 16]")
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Pool runs submitted tasks on a fixed number of worker goroutines.
+type Pool struct {
+	tasks  chan func()
+	wg     sync.WaitGroup
+	panics firstPanic
+}
+
+// NewPool starts a pool with the given number of workers (at least one).
+func NewPool(workers int) *Pool {
+	workers = IntMax(workers, 1)
+
+	p := &Pool{tasks: make(chan func(), workers)}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer p.wg.Done()
+			for task := range p.tasks {
+				p.panics.call(task)
+			}
+		}()
+	}
+	return p
+}
+
+// Submit queues task to run on one of the workers. It blocks while all
+// workers are busy and the queue is full.
+func (p *Pool) Submit(task func()) {
+	p.tasks <- task
+}
+
+// Wait stops accepting tasks and blocks until every submitted task has
+// finished. The pool cannot be used after Wait.
+//
+// A panicking task does not take its worker down. Instead Wait panics in
+// the caller's goroutine with a *PanicError for the first panic, after all
+// the other tasks have finished.
+func (p *Pool) Wait() {
+	close(p.tasks)
+	p.wg.Wait()
+	p.panics.repanic()
+}
+
+// RunLimited runs all tasks and waits for them, with at most maxConcurrent
+// of them executing at the same time. Like Pool.Wait, it re-raises the
+// first task panic in the caller's goroutine once all tasks are done.
+func RunLimited(tasks []func(), maxConcurrent int) {
+	sem := make(chan struct{}, IntMax(maxConcurrent, 1))
+	var wg sync.WaitGroup
+	var p firstPanic
+
+	for _, task := range tasks {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(task func()) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			p.call(task)
+		}(task)
+	}
+
+	wg.Wait()
+	p.repanic()
+}
+
+// RunPoolContext runs tasks on workers goroutines and waits for them. Once
+// ctx is cancelled no further tasks are started; tasks already running get
+// the cancelled ctx and are waited for, and ctx.Err() is returned.
+//
+// A panicking task does not stop the others: the panic is recovered as a
+// *PanicError, and all of them are joined with ctx.Err() into the returned
+// error. Without panics the error is ctx.Err() itself.
+func RunPoolContext(ctx context.Context, tasks []func(context.Context), workers int) error {
+	queue := make(chan func(context.Context))
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i := 0; i < IntMax(workers, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				err := safeCall(func() error {
+					task(ctx)
+					return nil
+				})
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for _, task := range tasks {
+		// select picks at random when both cases are ready, so check first
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case queue <- task:
+		}
+	}
+	close(queue)
+
+	wg.Wait()
+	if len(errs) == 0 {
+		return ctx.Err()
+	}
+	return errors.Join(append(errs, ctx.Err())...)
+}
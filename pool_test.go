@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// peakTracker records how many tracked tasks run at the same time.
+type peakTracker struct {
+	running, peak atomic.Int64
+}
+
+func (p *peakTracker) task(d time.Duration) func() {
+	return func() {
+		n := p.running.Add(1)
+		for {
+			old := p.peak.Load()
+			if n <= old || p.peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(d)
+		p.running.Add(-1)
+	}
+}
+
+func TestPool(t *testing.T) {
+	for _, workers := range []int{1, 4, 10} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			var count atomic.Int64
+			var pt peakTracker
+
+			p := NewPool(workers)
+			for i := 0; i < 100; i++ {
+				track := pt.task(time.Millisecond)
+				p.Submit(func() {
+					track()
+					count.Add(1)
+				})
+			}
+			p.Wait()
+
+			if ans := count.Load(); ans != 100 {
+				t.Errorf("got %d, want %d", ans, 100)
+			}
+			if ans := pt.peak.Load(); ans > int64(workers) {
+				t.Errorf("got peak %d, want at most %d", ans, workers)
+			}
+		})
+	}
+}
+
+func TestRunLimited(t *testing.T) {
+	var tests = []struct {
+		tasks, limit int
+		wantPeak     int64
+	}{
+		{20, 1, 1},
+		{20, 3, 3},
+		{5, 10, 5},
+		{10, 0, 1},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("tasks=%d,limit=%d", tt.tasks, tt.limit)
+		t.Run(testname, func(t *testing.T) {
+			var pt peakTracker
+			tasks := make([]func(), tt.tasks)
+			for i := range tasks {
+				tasks[i] = pt.task(5 * time.Millisecond)
+			}
+
+			RunLimited(tasks, tt.limit)
+
+			if ans := pt.running.Load(); ans != 0 {
+				t.Errorf("got %d still running, want 0", ans)
+			}
+			if ans := pt.peak.Load(); ans > tt.wantPeak {
+				t.Errorf("got peak %d, want at most %d", ans, tt.wantPeak)
+			}
+		})
+	}
+}
+
+func TestRunPoolContextCancel(t *testing.T) {
+	const workers = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var started atomic.Int64
+	firstBatch := make(chan struct{}, workers)
+	release := make(chan struct{})
+
+	tasks := make([]func(context.Context), 10)
+	for i := range tasks {
+		tasks[i] = func(ctx context.Context) {
+			started.Add(1)
+			firstBatch <- struct{}{}
+			<-release
+		}
+	}
+
+	go func() {
+		for i := 0; i < workers; i++ {
+			<-firstBatch
+		}
+		cancel()
+		close(release)
+	}()
+
+	err := RunPoolContext(ctx, tasks, workers)
+	if err != context.Canceled {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+	if ans := started.Load(); ans != workers {
+		t.Errorf("got %d tasks started, want %d", ans, workers)
+	}
+}
+
+func TestRunPoolContextAll(t *testing.T) {
+	var count atomic.Int64
+	tasks := make([]func(context.Context), 20)
+	for i := range tasks {
+		tasks[i] = func(context.Context) { count.Add(1) }
+	}
+
+	if err := RunPoolContext(context.Background(), tasks, 3); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+	if ans := count.Load(); ans != 20 {
+		t.Errorf("got %d, want %d", ans, 20)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import "time"
+
+// RateLimiter is a token bucket allowing rate events per period. The bucket
+// starts full, so up to rate events may happen in a burst.
+type RateLimiter struct {
+	tokens chan struct{}
+	ticker *time.Ticker
+	stop   chan struct{}
+}
+
+// NewRateLimiter returns a limiter refilling one token every per/rate.
+// Stop must be called to release its refill goroutine.
+func NewRateLimiter(rate int, per time.Duration) *RateLimiter {
+	rate = IntMax(rate, 1)
+
+	l := &RateLimiter{
+		tokens: make(chan struct{}, rate),
+		ticker: time.NewTicker(Max(per/time.Duration(rate), 1)),
+		stop:   make(chan struct{}),
+	}
+	for i := 0; i < rate; i++ {
+		l.tokens <- struct{}{}
+	}
+
+	go l.refill()
+	return l
+}
+
+func (l *RateLimiter) refill() {
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-l.ticker.C:
+			select {
+			case l.tokens <- struct{}{}:
+			default: // bucket is full
+			}
+		}
+	}
+}
+
+// Wait blocks until a token is available and takes it.
+func (l *RateLimiter) Wait() {
+	<-l.tokens
+}
+
+// Allow takes a token if one is available and reports whether it did.
+func (l *RateLimiter) Allow() bool {
+	select {
+	case <-l.tokens:
+		return true
+	default:
+		return false
+	}
+}
+
+// Stop stops refilling the bucket.
+func (l *RateLimiter) Stop() {
+	l.ticker.Stop()
+	close(l.stop)
+}
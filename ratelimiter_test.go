@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	const rate = 10
+	const per = 100 * time.Millisecond
+
+	l := NewRateLimiter(rate, per)
+	defer l.Stop()
+
+	// the full bucket gives a burst of rate, and about rate more are
+	// refilled while the loop runs
+	allowed := 0
+	deadline := time.Now().Add(per)
+	for time.Now().Before(deadline) {
+		if l.Allow() {
+			allowed++
+		}
+	}
+
+	if allowed < rate || allowed > 2*rate+2 {
+		t.Errorf("got %d allowed, want between %d and %d", allowed, rate, 2*rate+2)
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := NewRateLimiter(5, time.Hour)
+	defer l.Stop()
+
+	for i := 0; i < 5; i++ {
+		if !l.Allow() {
+			t.Fatalf("call %d: got false, want true", i)
+		}
+	}
+	if l.Allow() {
+		t.Errorf("got true after the burst, want false")
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is the error a recovered panic in a task is turned into.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v\n\n%s", e.Value, e.Stack)
+}
+
+// safeCall runs fn and returns its error, or a *PanicError if it panicked.
+func safeCall(fn func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return fn()
+}
+
+// firstPanic recovers panics in worker goroutines of helpers that have no
+// error to return them through. It keeps the first one, for the helper to
+// re-raise in its caller's goroutine once the workers are done.
+type firstPanic struct {
+	once sync.Once
+	err  error
+}
+
+// call runs fn, recording a panic instead of letting it crash the process.
+func (p *firstPanic) call(fn func()) {
+	err := safeCall(func() error {
+		fn()
+		return nil
+	})
+	if err != nil {
+		p.once.Do(func() {
+			p.err = err
+		})
+	}
+}
+
+// repanic panics with the recorded *PanicError, if there is one. It must
+// only be called after every call has returned.
+func (p *firstPanic) repanic() {
+	if p.err != nil {
+		panic(p.err)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRunPoolContextPanic(t *testing.T) {
+	var count atomic.Int64
+	tasks := make([]func(context.Context), 10)
+	for i := range tasks {
+		i := i
+		tasks[i] = func(context.Context) {
+			if i == 4 {
+				panic("task 4 is buggy")
+			}
+			count.Add(1)
+		}
+	}
+
+	err := RunPoolContext(context.Background(), tasks, 3)
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %v, want a *PanicError", err)
+	}
+	if pe.Value != "task 4 is buggy" {
+		t.Errorf("got %v, want %q", pe.Value, "task 4 is buggy")
+	}
+	if len(pe.Stack) == 0 {
+		t.Errorf("got no stack trace")
+	}
+	if ans := count.Load(); ans != 9 {
+		t.Errorf("got %d tasks completed, want %d", ans, 9)
+	}
+}
+
+func TestErrGroupPanic(t *testing.T) {
+	var g ErrGroup
+	var count atomic.Int64
+	for i := 0; i < 5; i++ {
+		i := i
+		g.Go(func() error {
+			if i == 2 {
+				panic(errors.New("boom"))
+			}
+			count.Add(1)
+			return nil
+		})
+	}
+
+	err := g.Wait()
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("got %v, want a *PanicError", err)
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Errorf("got %q, want it to mention the panic value", err)
+	}
+	if ans := count.Load(); ans != 4 {
+		t.Errorf("got %d functions completed, want %d", ans, 4)
+	}
+}
+
+// catchPanic runs fn and returns the value it panicked with, if any.
+func catchPanic(fn func()) (v any) {
+	defer func() {
+		v = recover()
+	}()
+	fn()
+	return nil
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	var count atomic.Int64
+	v := catchPanic(func() {
+		ParallelMap([]int{1, 2, 3, 4, 5}, 2, func(i int) int {
+			if i == 3 {
+				panic("three")
+			}
+			count.Add(1)
+			return i
+		})
+	})
+
+	pe, ok := v.(*PanicError)
+	if !ok || pe.Value != "three" {
+		t.Fatalf("got panic %v, want a *PanicError for %q", v, "three")
+	}
+	if ans := count.Load(); ans != 4 {
+		t.Errorf("got %d calls completed, want %d", ans, 4)
+	}
+}
+
+func TestPoolPanic(t *testing.T) {
+	var count atomic.Int64
+	p := NewPool(2)
+	for i := 0; i < 10; i++ {
+		i := i
+		p.Submit(func() {
+			if i == 0 {
+				panic("first task")
+			}
+			count.Add(1)
+		})
+	}
+
+	v := catchPanic(p.Wait)
+	if pe, ok := v.(*PanicError); !ok || pe.Value != "first task" {
+		t.Fatalf("got panic %v, want a *PanicError for %q", v, "first task")
+	}
+	if ans := count.Load(); ans != 9 {
+		t.Errorf("got %d tasks completed, want %d", ans, 9)
+	}
+}
+
+func TestRunLimitedPanic(t *testing.T) {
+	var count atomic.Int64
+	tasks := make([]func(), 6)
+	for i := range tasks {
+		i := i
+		tasks[i] = func() {
+			if i == 5 {
+				panic("last task")
+			}
+			count.Add(1)
+		}
+	}
+
+	v := catchPanic(func() { RunLimited(tasks, 2) })
+	if pe, ok := v.(*PanicError); !ok || pe.Value != "last task" {
+		t.Fatalf("got panic %v, want a *PanicError for %q", v, "last task")
+	}
+	if ans := count.Load(); ans != 5 {
+		t.Errorf("got %d tasks completed, want %d", ans, 5)
+	}
+}
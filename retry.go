@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// maxBackoff caps the delay between tries, however many there are.
+const maxBackoff = 30 * time.Second
+
+// Retry calls fn up to attempts times until it returns nil. Between tries
+// it sleeps base, 2*base, 4*base, ... up to maxBackoff, each with random
+// jitter of up to half the delay. The error of the last try is returned.
+// fn is always called at least once, even if attempts is zero or negative.
+func Retry(attempts int, base time.Duration, fn func() error) error {
+	return RetryContext(context.Background(), attempts, base, fn)
+}
+
+// RetryContext is Retry that also stops waiting once ctx is done, returning
+// ctx.Err().
+func RetryContext(ctx context.Context, attempts int, base time.Duration, fn func() error) error {
+	attempts = Max(attempts, 1)
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if err = fn(); err == nil {
+			return nil
+		}
+		if i == attempts-1 {
+			break
+		}
+
+		timer := time.NewTimer(backoff(base, i))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return err
+}
+
+// backoff returns the delay before retry number i: base*2^i capped at
+// maxBackoff, reduced by a random amount of up to half so concurrent
+// callers spread out.
+func backoff(base time.Duration, i int) time.Duration {
+	d := maxBackoff
+	// shifting past maxBackoff could overflow, so only shift while it fits
+	if i < 63 && base <= maxBackoff>>i {
+		d = Min(base<<i, maxBackoff)
+	}
+	if half := int64(d / 2); half > 0 {
+		d -= time.Duration(rand.Int63n(half))
+	}
+	return d
+}
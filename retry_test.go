@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	errFail := errors.New("fail")
+
+	var tests = []struct {
+		attempts  int
+		succeedOn int // call number that returns nil, 0 for never
+		wantCalls int
+		wantErr   error
+	}{
+		{3, 1, 1, nil},
+		{3, 2, 2, nil},
+		{3, 0, 3, errFail},
+		{5, 0, 5, errFail},
+		{0, 0, 1, errFail},
+		{-1, 1, 1, nil},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("attempts=%d,succeedOn=%d", tt.attempts, tt.succeedOn)
+		t.Run(testname, func(t *testing.T) {
+			calls := 0
+			err := Retry(tt.attempts, time.Millisecond, func() error {
+				calls++
+				if calls == tt.succeedOn {
+					return nil
+				}
+				return errFail
+			})
+			if err != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := RetryContext(ctx, 10, time.Hour, func() error {
+		calls++
+		cancel()
+		return errors.New("fail")
+	})
+	if err != context.Canceled {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+	if calls != 1 {
+		t.Errorf("got %d calls, want 1", calls)
+	}
+}
+
+func TestBackoffCapped(t *testing.T) {
+	for _, i := range []int{0, 10, 40, 62, 63, 100} {
+		if d := backoff(time.Second, i); d <= 0 || d > maxBackoff {
+			t.Errorf("backoff(1s, %d) = %v, want in (0, %v]", i, d, maxBackoff)
+		}
+	}
+}
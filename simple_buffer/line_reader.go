@@ -0,0 +1,40 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// LineReader reads lines through a single bufio.Reader that can be pointed
+// at a new source with Reset, so its buffer is allocated only once.
+type LineReader struct {
+	br *bufio.Reader
+}
+
+// NewLineReader returns a LineReader reading from r.
+func NewLineReader(r io.Reader) *LineReader {
+	return &LineReader{br: bufio.NewReader(r)}
+}
+
+// Reset discards any buffered data and switches to reading from r,
+// keeping the existing buffer.
+func (l *LineReader) Reset(r io.Reader) {
+	l.br.Reset(r)
+}
+
+// NextLine returns the next line without its line ending. A final line
+// without a trailing newline is returned as well; after that io.EOF.
+func (l *LineReader) NextLine() (string, error) {
+	line, err := l.br.ReadString('\n')
+	if err == io.EOF && line != "" {
+		err = nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return line, nil
+}
@@ -0,0 +1,39 @@
+package simple_buffer
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func readLines(t *testing.T, l *LineReader) []string {
+	t.Helper()
+
+	var lines []string
+	for {
+		line, err := l.NextLine()
+		if err == io.EOF {
+			return lines
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, line)
+	}
+}
+
+func TestLineReaderReset(t *testing.T) {
+	l := NewLineReader(strings.NewReader("one\ntwo\r\nthree"))
+
+	got := readLines(t, l)
+	if strings.Join(got, ",") != "one,two,three" {
+		t.Errorf("first source: got %q", got)
+	}
+
+	l.Reset(strings.NewReader("four\n\nfive\n"))
+
+	got = readLines(t, l)
+	if strings.Join(got, ",") != "four,,five" {
+		t.Errorf("second source: got %q", got)
+	}
+}
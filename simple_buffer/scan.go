@@ -0,0 +1,66 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"io"
+)
+
+// newScanner returns a scanner over r whose tokens may be up to
+// maxTokenSize bytes long. Zero or less keeps bufio's 64KB default.
+func newScanner(r io.Reader, maxTokenSize int) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	if maxTokenSize > 0 {
+		scanner.Buffer(make([]byte, 0, min(maxTokenSize, 4096)), maxTokenSize)
+	}
+	return scanner
+}
+
+// ScanUntil returns the whitespace separated words of r up to, but not
+// including, the first word equal to sentinel. Scanning stops there, so no
+// words after the sentinel are tokenized. If the sentinel never appears all
+// words are returned.
+//
+// Words may be up to maxTokenSize bytes (zero for bufio's default). A longer
+// word stops the scan and bufio.ErrTooLong is returned with the words read
+// before it.
+func ScanUntil(r io.Reader, sentinel string, maxTokenSize int) ([]string, error) {
+	scanner := newScanner(r, maxTokenSize)
+	scanner.Split(bufio.ScanWords)
+
+	var words []string
+	for scanner.Scan() {
+		if scanner.Text() == sentinel {
+			break
+		}
+		words = append(words, scanner.Text())
+	}
+	return words, scanner.Err()
+}
+
+// NumberedScanner reads lines like bufio.Scanner and numbers them,
+// starting at 1.
+type NumberedScanner struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+// NewNumberedScanner returns a NumberedScanner reading lines from r.
+func NewNumberedScanner(r io.Reader) *NumberedScanner {
+	return &NumberedScanner{scanner: bufio.NewScanner(r)}
+}
+
+// Next returns the next line and its number. A final line without a
+// trailing newline is returned too. ok is false once the input is used up
+// or an error occurred; see Err.
+func (s *NumberedScanner) Next() (lineNo int, text string, ok bool) {
+	if !s.scanner.Scan() {
+		return 0, "", false
+	}
+	s.line++
+	return s.line, s.scanner.Text(), true
+}
+
+// Err returns the first non-EOF error hit by Next.
+func (s *NumberedScanner) Err() error {
+	return s.scanner.Err()
+}
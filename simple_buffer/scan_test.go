@@ -0,0 +1,82 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestScanUntil(t *testing.T) {
+	var tests = []struct {
+		name, in string
+		want     string
+	}{
+		{"early", "STOP a b c", ""},
+		{"middle", "a b STOP c d", "a,b"},
+		{"end", "a b c STOP", "a,b,c"},
+		{"absent", "a b c", "a,b,c"},
+		{"empty", "", ""},
+		{"substring only", "a STOPPED b", "a,STOPPED,b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ScanUntil(strings.NewReader(tt.in), "STOP", 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ans := strings.Join(got, ","); ans != tt.want {
+				t.Errorf("got %q, want %q", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanUntilLongToken(t *testing.T) {
+	long := strings.Repeat("x", 100<<10)
+	in := "a " + long + " b"
+
+	// bufio's default 64KB limit is too small for the long word
+	got, err := ScanUntil(strings.NewReader(in), "STOP", 0)
+	if err != bufio.ErrTooLong {
+		t.Errorf("got %v, want %v", err, bufio.ErrTooLong)
+	}
+	if ans := strings.Join(got, ","); ans != "a" {
+		t.Errorf("got %q, want the words before the long one", ans)
+	}
+
+	got, err = ScanUntil(strings.NewReader(in), "STOP", 200<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[1] != long {
+		t.Errorf("got %d words, want a, the long word and b", len(got))
+	}
+}
+
+func TestNumberedScanner(t *testing.T) {
+	var tests = []struct {
+		name, in string
+	}{
+		{"trailing newline", "one\ntwo\nthree\n"},
+		{"no trailing newline", "one\ntwo\nthree"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewNumberedScanner(strings.NewReader(tt.in))
+			for i, want := range []string{"one", "two", "three"} {
+				lineNo, text, ok := s.Next()
+				if !ok || lineNo != i+1 || text != want {
+					t.Errorf("got %d %q %v, want %d %q true", lineNo, text, ok, i+1, want)
+				}
+			}
+			if _, _, ok := s.Next(); ok {
+				t.Errorf("got a fourth line, want none")
+			}
+			if err := s.Err(); err != nil {
+				t.Errorf("got %v, want nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Labels returned by SniffProtocol.
+const (
+	ProtocolText   = "text"
+	ProtocolJSON   = "json"
+	ProtocolBinary = "binary"
+)
+
+// MinSniffBufferSize is the reader size SniffProtocol needs to look at its
+// full window. Smaller readers are sniffed on as many bytes as they hold.
+const MinSniffBufferSize = 64
+
+// ErrSniffUndecided is returned by SniffProtocol when all the bytes it
+// looked at are whitespace, so the format cannot be told yet.
+var ErrSniffUndecided = errors.New("sniff protocol: only whitespace so far")
+
+// SniffProtocol guesses the format of the data waiting in r without
+// consuming any of it:
+//
+//   - binary: length-prefixed frames, whose 4-byte big-endian header
+//     contains control bytes (a zero high byte for any sane length)
+//   - json: the first non-space byte is '{' or '['
+//   - text: anything else, e.g. plain lines
+//
+// It waits only for the first byte, and returns io.EOF if r is empty.
+// After that it looks at what is already buffered, up to
+// MinSniffBufferSize bytes, so a peer that sends a short greeting and then
+// waits for a reply is not stalled.
+//
+// If the bytes looked at are all whitespace, whatever the buffer size, the
+// error wraps ErrSniffUndecided: the caller may skip the whitespace or wait
+// for more data and sniff again. If r's buffer is already full with them,
+// waiting cannot help, and the error also wraps bufio.ErrBufferFull and
+// recommends a reader of at least MinSniffBufferSize bytes.
+func SniffProtocol(r *bufio.Reader) (string, error) {
+	if _, err := r.Peek(1); err != nil {
+		return "", err
+	}
+
+	// never more than is buffered, so this Peek neither blocks nor fails
+	head, _ := r.Peek(min(r.Buffered(), MinSniffBufferSize))
+
+	for _, c := range head[:min(len(head), 4)] {
+		if c < ' ' && c != '\t' && c != '\n' && c != '\r' {
+			return ProtocolBinary, nil
+		}
+	}
+
+	trimmed := bytes.TrimLeft(head, " \t\r\n")
+	if len(trimmed) == 0 {
+		if len(head) == r.Size() {
+			// a bigger buffer might have reached the first real byte
+			return "", bufferTooSmall(r)
+		}
+		return "", ErrSniffUndecided
+	}
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		return ProtocolJSON, nil
+	}
+	return ProtocolText, nil
+}
+
+func bufferTooSmall(r *bufio.Reader) error {
+	return fmt.Errorf("%w: %d-byte reader too small, use bufio.NewReaderSize with at least %d: %w",
+		ErrSniffUndecided, r.Size(), MinSniffBufferSize, bufio.ErrBufferFull)
+}
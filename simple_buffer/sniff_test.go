@@ -0,0 +1,104 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSniffProtocol(t *testing.T) {
+	var tests = []struct {
+		name, in string
+		want     string
+	}{
+		{"text", "HELLO server\n", ProtocolText},
+		{"json object", `{"cmd":"ping"}`, ProtocolJSON},
+		{"json array", "  \n[1, 2]", ProtocolJSON},
+		{"binary frame", "\x00\x00\x00\x05hello", ProtocolBinary},
+		{"short text", "hi", ProtocolText},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bufio.NewReader(strings.NewReader(tt.in))
+
+			ans, err := SniffProtocol(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ans != tt.want {
+				t.Errorf("got %s, want %s", ans, tt.want)
+			}
+
+			// sniffing must not consume anything
+			rest, _ := io.ReadAll(r)
+			if string(rest) != tt.in {
+				t.Errorf("got %q left, want %q", rest, tt.in)
+			}
+		})
+	}
+}
+
+func TestSniffProtocolEmpty(t *testing.T) {
+	if _, err := SniffProtocol(bufio.NewReader(strings.NewReader(""))); err != io.EOF {
+		t.Errorf("got %v, want %v", err, io.EOF)
+	}
+}
+
+func TestSniffProtocolLivePipe(t *testing.T) {
+	pr, pw := io.Pipe()
+	defer pw.Close()
+
+	// the peer sends a short greeting and then waits without closing
+	go pw.Write([]byte(`{"a":`))
+
+	type result struct {
+		label string
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		label, err := SniffProtocol(bufio.NewReader(pr))
+		done <- result{label, err}
+	}()
+
+	select {
+	case res := <-done:
+		if res.err != nil || res.label != ProtocolJSON {
+			t.Errorf("got %s, %v, want %s", res.label, res.err, ProtocolJSON)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("SniffProtocol blocked waiting for more data")
+	}
+}
+
+func TestSniffProtocolUndersizedReader(t *testing.T) {
+	// 20 spaces do not fit into bufio's smallest 16-byte buffer
+	in := strings.Repeat(" ", 20) + `{"cmd":"ping"}`
+
+	_, err := SniffProtocol(bufio.NewReaderSize(strings.NewReader(in), 16))
+	if !errors.Is(err, bufio.ErrBufferFull) || !errors.Is(err, ErrSniffUndecided) {
+		t.Errorf("got %v, want it to wrap %v and %v", err, bufio.ErrBufferFull, ErrSniffUndecided)
+	}
+	if err != nil && !strings.Contains(err.Error(), "at least 64") {
+		t.Errorf("got %q, want it to recommend a bigger reader", err)
+	}
+
+	ans, err := SniffProtocol(bufio.NewReaderSize(strings.NewReader(in), MinSniffBufferSize))
+	if err != nil || ans != ProtocolJSON {
+		t.Errorf("got %s, %v, want %s", ans, err, ProtocolJSON)
+	}
+}
+
+func TestSniffProtocolOnlyWhitespace(t *testing.T) {
+	// the same rule for every buffer size that is not full
+	for _, size := range []int{16, MinSniffBufferSize, 4096} {
+		r := bufio.NewReaderSize(strings.NewReader(" \n\t "), size)
+		if _, err := SniffProtocol(r); err != ErrSniffUndecided {
+			t.Errorf("size %d: got %v, want %v", size, err, ErrSniffUndecided)
+		}
+	}
+}
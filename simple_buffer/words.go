@@ -0,0 +1,73 @@
+package simple_buffer
+
+import (
+	"bufio"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultIsWordChar treats every non-space rune as part of a word, which
+// counts the same words as bufio.ScanWords.
+func DefaultIsWordChar(r rune) bool {
+	return !unicode.IsSpace(r)
+}
+
+// CountWords counts the whitespace separated words in r.
+func CountWords(r io.Reader) (int, error) {
+	return CountWordsFunc(r, DefaultIsWordChar)
+}
+
+// CountWordsFunc counts the words in r, where a word is a maximal run of
+// runes for which isWordChar returns true. For example unicode.IsLetter
+// splits "well-known" into two words, while a predicate that also accepts
+// '-' keeps it as one.
+func CountWordsFunc(r io.Reader, isWordChar func(rune) bool) (int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(scanWordsFunc(isWordChar))
+
+	count := 0
+	for scanner.Scan() {
+		count++
+	}
+	return count, scanner.Err()
+}
+
+// scanWordsFunc is bufio.ScanWords with the word definition taken from
+// isWordChar instead of fixed to non-space runes.
+func scanWordsFunc(isWordChar func(rune) bool) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (advance int, token []byte, err error) {
+		// skip leading separators
+		start := 0
+		for start < len(data) {
+			if !atEOF && !utf8.FullRune(data[start:]) {
+				return start, nil, nil
+			}
+			r, width := utf8.DecodeRune(data[start:])
+			if isWordChar(r) {
+				break
+			}
+			start += width
+		}
+
+		// scan until the first separator, which ends the word
+		for i := start; i < len(data); {
+			// a rune split across reads needs more data before deciding
+			if !atEOF && !utf8.FullRune(data[i:]) {
+				return start, nil, nil
+			}
+			r, width := utf8.DecodeRune(data[i:])
+			if !isWordChar(r) {
+				return i + width, data[start:i], nil
+			}
+			i += width
+		}
+
+		// at EOF a non-empty remainder is the last word
+		if atEOF && len(data) > start {
+			return len(data), data[start:], nil
+		}
+		// request more data
+		return start, nil, nil
+	}
+}
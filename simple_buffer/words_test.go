@@ -0,0 +1,51 @@
+package simple_buffer
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unicode"
+)
+
+func TestCountWordsFunc(t *testing.T) {
+	lettersAndHyphens := func(r rune) bool {
+		return unicode.IsLetter(r) || r == '-'
+	}
+
+	var tests = []struct {
+		in              string
+		letters, hyphen int
+	}{
+		{"well-known fact", 3, 2},
+		{"a state-of-the-art tool", 6, 3},
+		{"no hyphens here", 3, 3},
+		{"  ", 0, 0},
+		{"", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			ans, err := CountWordsFunc(strings.NewReader(tt.in), unicode.IsLetter)
+			if err != nil || ans != tt.letters {
+				t.Errorf("letters: got %d, %v, want %d", ans, err, tt.letters)
+			}
+			ans, err = CountWordsFunc(strings.NewReader(tt.in), lettersAndHyphens)
+			if err != nil || ans != tt.hyphen {
+				t.Errorf("letters and hyphens: got %d, %v, want %d", ans, err, tt.hyphen)
+			}
+		})
+	}
+}
+
+func TestCountWordsMatchesScanWords(t *testing.T) {
+	in := "héllo  wörld\tfoo-bar\nbaz "
+
+	// one byte at a time splits the multi-byte runes across reads
+	ans, err := CountWords(iotest.OneByteReader(strings.NewReader(in)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := len(strings.Fields(in)); ans != want {
+		t.Errorf("got %d, want %d", ans, want)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+// IndexOf returns the index of the first occurrence of v in s, or -1 if v
+// is not present.
+func IndexOf[T comparable](s []T, v T) int {
+	for i, e := range s {
+		if e == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether v is present in s.
+func Contains[T comparable](s []T, v T) bool {
+	return IndexOf(s, v) >= 0
+}
+
+// Filter returns the elements of s for which keep returns true, in order.
+// The result is never nil: if nothing matches an empty slice is returned.
+func Filter[T any](s []T, keep func(T) bool) []T {
+	out := make([]T, 0)
+	for _, e := range s {
+		if keep(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Reduce folds s into a single value, starting from init and combining it
+// with each element in turn.
+func Reduce[T, R any](s []T, init R, f func(R, T) R) R {
+	acc := init
+	for _, e := range s {
+		acc = f(acc, e)
+	}
+	return acc
+}
+
+// Chunk splits s into consecutive batches of size elements; the last batch
+// may be shorter. The batches share s's backing array. Chunk panics if size
+// is not positive.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("Chunk: size must be positive")
+	}
+
+	// len(s)+size-1 could overflow for a huge size
+	n := len(s) / size
+	if len(s)%size != 0 {
+		n++
+	}
+
+	chunks := make([][]T, 0, n)
+	for len(s) > 0 {
+		end := IntMin(size, len(s))
+		chunks = append(chunks, s[:end:end])
+		s = s[end:]
+	}
+	return chunks
+}
+
+// Unique returns s without duplicates, keeping the first occurrence of each
+// value in its original order.
+func Unique[T comparable](s []T) []T {
+	seen := make(map[T]struct{}, len(s))
+	out := make([]T, 0, len(s))
+	for _, e := range s {
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		out = append(out, e)
+	}
+	return out
+}
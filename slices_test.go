@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestIndexOfContains(t *testing.T) {
+	var tests = []struct {
+		s        []int
+		v        int
+		want     int
+		contains bool
+	}{
+		{[]int{1, 2, 3}, 2, 1, true},
+		{[]int{1, 2, 3}, 4, -1, false},
+		{[]int{}, 1, -1, false},
+		{nil, 0, -1, false},
+		{[]int{5, 7, 5, 7}, 7, 1, true},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%d", tt.s, tt.v)
+		t.Run(testname, func(t *testing.T) {
+			if ans := IndexOf(tt.s, tt.v); ans != tt.want {
+				t.Errorf("IndexOf: got %d, want %d", ans, tt.want)
+			}
+			if ans := Contains(tt.s, tt.v); ans != tt.contains {
+				t.Errorf("Contains: got %v, want %v", ans, tt.contains)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	var tests = []struct {
+		s    []int
+		want []int
+	}{
+		{[]int{1, 2, 3, 4}, []int{2, 4}},
+		{[]int{2, 4}, []int{2, 4}},
+		{[]int{1, 3, 5}, []int{}},
+		{nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.s), func(t *testing.T) {
+			ans := Filter(tt.s, isEven)
+			if ans == nil {
+				t.Fatalf("got nil, want a non-nil slice")
+			}
+			if fmt.Sprint(ans) != fmt.Sprint(tt.want) {
+				t.Errorf("got %v, want %v", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := Reduce([]int{1, 2, 3, 4}, 0, func(acc, v int) int { return acc + v })
+	if sum != 10 {
+		t.Errorf("got %d, want %d", sum, 10)
+	}
+
+	joined := Reduce([]int{1, 2, 3}, "", func(acc string, v int) string { return acc + fmt.Sprint(v) })
+	if joined != "123" {
+		t.Errorf("got %q, want %q", joined, "123")
+	}
+
+	if ans := Reduce(nil, 7, func(acc, v int) int { return acc + v }); ans != 7 {
+		t.Errorf("got %d, want %d", ans, 7)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	var tests = []struct {
+		s    []int
+		size int
+		want string
+	}{
+		{[]int{1, 2, 3, 4}, 2, "[[1 2] [3 4]]"},
+		{[]int{1, 2, 3, 4, 5}, 2, "[[1 2] [3 4] [5]]"},
+		{[]int{}, 3, "[]"},
+		{[]int{1, 2}, 5, "[[1 2]]"},
+		{[]int{1, 2, 3}, 1, "[[1] [2] [3]]"},
+		{[]int{1, 2}, math.MaxInt, "[[1 2]]"},
+	}
+
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%v,%d", tt.s, tt.size)
+		t.Run(testname, func(t *testing.T) {
+			if ans := fmt.Sprint(Chunk(tt.s, tt.size)); ans != tt.want {
+				t.Errorf("got %s, want %s", ans, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkNoOverlap(t *testing.T) {
+	s := []int{1, 2, 3, 4, 5}
+	chunks := Chunk(s, 2)
+
+	// appending to a chunk must not overwrite the next one
+	_ = append(chunks[0], 99)
+	if chunks[1][0] != 3 {
+		t.Errorf("got %d, want %d", chunks[1][0], 3)
+	}
+}
+
+func TestChunkPanics(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		t.Run(fmt.Sprint(size), func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("got no panic, want one")
+				}
+			}()
+			Chunk([]int{1}, size)
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	var tests = []struct {
+		s    []string
+		want string
+	}{
+		{[]string{"a", "b", "c"}, "[a b c]"},
+		{[]string{"a", "a", "a"}, "[a]"},
+		{[]string{"b", "a", "b", "c", "a"}, "[b a c]"},
+		{nil, "[]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.s), func(t *testing.T) {
+			if ans := fmt.Sprint(Unique(tt.s)); ans != tt.want {
+				t.Errorf("got %s, want %s", ans, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// WaitTimeout waits for wg like wg.Wait, but gives up after d. It reports
+// whether the group finished in time. On timeout the goroutine blocked in
+// wg.Wait keeps running until the group does finish.
+func WaitTimeout(wg *sync.WaitGroup, d time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// SafeWaitGroup is a sync.WaitGroup that can also be waited on with a
+// timeout, so an Add/Done imbalance shows up as a false result instead of
+// a hang.
+type SafeWaitGroup struct {
+	sync.WaitGroup
+}
+
+// WaitTimeout reports whether the group finished within d.
+func (wg *SafeWaitGroup) WaitTimeout(d time.Duration) bool {
+	return WaitTimeout(&wg.WaitGroup, d)
+}
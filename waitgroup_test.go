@@ -0,0 +1,60 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWaitTimeoutDone(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(5 * time.Millisecond)
+	}()
+
+	if !WaitTimeout(&wg, time.Second) {
+		t.Errorf("got false, want true")
+	}
+}
+
+func TestWaitTimeoutExpired(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Done()
+
+	start := time.Now()
+	if WaitTimeout(&wg, 20*time.Millisecond) {
+		t.Errorf("got true, want false")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("took %v, want about 20ms", elapsed)
+	}
+}
+
+func TestSafeWaitGroup(t *testing.T) {
+	var wg SafeWaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(time.Millisecond)
+		}()
+	}
+	if !wg.WaitTimeout(time.Second) {
+		t.Errorf("got false, want true")
+	}
+}
+
+func TestSafeWaitGroupImbalance(t *testing.T) {
+	var wg SafeWaitGroup
+	// one Add too many: Wait alone would hang forever
+	wg.Add(2)
+	wg.Done()
+
+	if wg.WaitTimeout(20 * time.Millisecond) {
+		t.Errorf("got true, want false")
+	}
+	wg.Done()
+}
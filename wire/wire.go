@@ -0,0 +1,75 @@
+// Package wire frames string messages as single lines so both ends of a
+// line-oriented connection agree on where a message stops.
+//
+// Each message is written followed by '\n'. Newlines inside a message are
+// escaped so they cannot end the frame early:
+//
+//	'\\' is written as `\\`
+//	'\n' is written as `\n`
+//	'\r' is written as `\r`
+//
+// Any other backslash sequence is rejected by Decode.
+package wire
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrBadEscape is returned by Decode for an unknown backslash sequence.
+var ErrBadEscape = errors.New("wire: invalid escape sequence")
+
+var escaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\r", `\r`)
+
+// Encode writes msg to w as one escaped, newline-terminated line.
+func Encode(w io.Writer, msg string) error {
+	_, err := io.WriteString(w, escaper.Replace(msg)+"\n")
+	return err
+}
+
+// Decode reads one line written by Encode and returns the original message.
+// It returns io.EOF if r has no more input and io.ErrUnexpectedEOF if the
+// input stops in the middle of a line.
+func Decode(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		return "", io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return unescape(line[:len(line)-1])
+}
+
+func unescape(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		if i == len(s) {
+			return "", ErrBadEscape
+		}
+		switch s[i] {
+		case '\\':
+			b.WriteByte('\\')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			return "", ErrBadEscape
+		}
+	}
+	return b.String(), nil
+}
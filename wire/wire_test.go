@@ -0,0 +1,82 @@
+package wire
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var tests = []string{
+		"",
+		"hello",
+		"two\nlines",
+		"trailing newline\n",
+		`back\slash`,
+		`\n is not a newline`,
+		"crlf\r\n",
+		`\\\`,
+	}
+
+	for _, msg := range tests {
+		t.Run(fmt.Sprintf("%q", msg), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Encode(&buf, msg); err != nil {
+				t.Fatal(err)
+			}
+			if n := strings.Count(buf.String(), "\n"); n != 1 {
+				t.Errorf("got %d newlines on the wire, want 1", n)
+			}
+
+			ans, err := Decode(bufio.NewReader(&buf))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ans != msg {
+				t.Errorf("got %q, want %q", ans, msg)
+			}
+		})
+	}
+}
+
+func TestDecodeSequence(t *testing.T) {
+	var buf bytes.Buffer
+	msgs := []string{"a\nb", `c\d`, "e"}
+	for _, m := range msgs {
+		Encode(&buf, m)
+	}
+
+	r := bufio.NewReader(&buf)
+	for _, want := range msgs {
+		ans, err := Decode(r)
+		if err != nil || ans != want {
+			t.Errorf("got %q, %v, want %q", ans, err, want)
+		}
+	}
+	if _, err := Decode(r); err != io.EOF {
+		t.Errorf("got %v, want %v", err, io.EOF)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want error
+	}{
+		{`bad \x escape` + "\n", ErrBadEscape},
+		{`dangling \` + "\n", ErrBadEscape},
+		{"no newline", io.ErrUnexpectedEOF},
+		{"", io.EOF},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%q", tt.in), func(t *testing.T) {
+			if _, err := Decode(bufio.NewReader(strings.NewReader(tt.in))); err != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}